@@ -0,0 +1,20 @@
+# Reference a certificate uploaded outside Terraform
+data "cyberarksia_certificate" "internal_ca" {
+  cert_name = "internal-ca"
+}
+
+resource "cyberarksia_database_workspace" "prod_postgres" {
+  name                          = "prod-postgres-db"
+  database_type                 = "postgres"
+  address                       = "prod-postgres.example.com"
+  port                          = 5432
+  authentication_method         = "local_ephemeral_user"
+  cloud_provider                = "on_premise"
+  secret_id                     = cyberarksia_secret.postgres_admin.id
+  certificate_id                = data.cyberarksia_certificate.internal_ca.certificate_id
+  enable_certificate_validation = true
+}
+
+output "internal_ca_expires" {
+  value = data.cyberarksia_certificate.internal_ca.expiration_date
+}
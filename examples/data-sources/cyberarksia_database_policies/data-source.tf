@@ -0,0 +1,15 @@
+# Inventory every active production policy for an access review
+data "cyberarksia_database_policies" "prod_active" {
+  status     = "Active"
+  name_regex = "^prod-"
+}
+
+output "prod_active_policies" {
+  value = {
+    for policy in data.cyberarksia_database_policies.prod_active.policies :
+    policy.name => {
+      policy_id     = policy.policy_id
+      last_modified = policy.last_modified
+    }
+  }
+}
@@ -8,9 +8,15 @@ data "cyberarksia_database_policy" "db_admins" {
   name = "Database Administrators Policy"
 }
 
+# Only match the policy while it is active
+data "cyberarksia_database_policy" "active_db_admins" {
+  name   = "Database Administrators Policy"
+  status = "active"
+}
+
 # Use policy in database assignment resource
 resource "cyberarksia_database_policy_database_assignment" "prod_postgres" {
-  policy_id             = data.cyberarksia_database_policy.db_admins.id
+  policy_id             = data.cyberarksia_database_policy.db_admins.policy_id
   database_workspace_id = cyberarksia_database_workspace.prod.id
   authentication_method = "db_auth"
 
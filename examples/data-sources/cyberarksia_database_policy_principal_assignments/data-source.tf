@@ -0,0 +1,15 @@
+# Inspect who has access through a policy managed by another team
+data "cyberarksia_database_policy" "shared" {
+  name = "Shared-Database-Access"
+}
+
+data "cyberarksia_database_policy_principal_assignments" "shared" {
+  policy_id = data.cyberarksia_database_policy.shared.policy_id
+}
+
+output "shared_policy_users" {
+  value = [
+    for p in data.cyberarksia_database_policy_principal_assignments.shared.principals :
+    p.principal_name if p.principal_type == "USER"
+  ]
+}
@@ -0,0 +1,23 @@
+# List all production PostgreSQL workspaces on AWS
+data "cyberarksia_database_workspaces" "prod_postgres" {
+  name_prefix    = "prod-"
+  database_type  = "postgres-aws-rds"
+  cloud_provider = "aws"
+}
+
+data "cyberarksia_database_policy" "prod" {
+  name = "Production Read Access"
+}
+
+# Assign every matching workspace to a policy
+resource "cyberarksia_database_policy_database_assignment" "prod_postgres" {
+  for_each = { for ws in data.cyberarksia_database_workspaces.prod_postgres.workspaces : ws.name => ws }
+
+  policy_id             = data.cyberarksia_database_policy.prod.id
+  database_workspace_id = each.value.id
+  authentication_method = "db_auth"
+
+  db_auth_profile {
+    roles = ["readonly"]
+  }
+}
@@ -0,0 +1,15 @@
+# Reference a secret managed by a separate secrets pipeline
+data "cyberarksia_secret" "postgres_admin" {
+  name                = "prod-postgres-admin"
+  authentication_type = "local"
+}
+
+resource "cyberarksia_database_workspace" "prod_postgres" {
+  name                  = "prod-postgres-db"
+  database_type         = "postgres"
+  address               = "prod-postgres.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = data.cyberarksia_secret.postgres_admin.id
+}
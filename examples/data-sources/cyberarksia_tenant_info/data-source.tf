@@ -0,0 +1,13 @@
+# Read metadata about the tenant the provider is authenticated against
+data "cyberarksia_tenant_info" "current" {}
+
+# Use tenant metadata elsewhere in configuration
+output "tenant_details" {
+  value = {
+    tenant_id        = data.cyberarksia_tenant_info.current.tenant_id
+    subdomain        = data.cyberarksia_tenant_info.current.subdomain
+    domain           = data.cyberarksia_tenant_info.current.domain
+    sia_url          = data.cyberarksia_tenant_info.current.sia_url
+    provider_version = data.cyberarksia_tenant_info.current.provider_version
+  }
+}
@@ -0,0 +1,16 @@
+# Provider Retry Configuration
+#
+# This example widens the retry backoff for tenants that rate-limit aggressively.
+# Omitted attributes keep their defaults.
+
+provider "cyberarksia" {
+  username = "service-account@cyberark.cloud.12345"
+  password = var.cyberark_password
+
+  retry {
+    max_retries           = 6
+    base_delay_ms         = 1000
+    max_delay_ms          = 30000
+    retry_on_status_codes = [429, 502, 503, 504]
+  }
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"io"
@@ -36,8 +37,9 @@ const (
 //
 // Reference: /pkg/services/sia/workspaces/db/ark_sia_workspaces_db_service.go
 type CertificatesClient struct {
-	authCtx *ISPAuthContext          // Provider's authentication context (in-memory profile)
-	client  *isp.ArkISPServiceClient // SDK's authenticated HTTP client
+	authCtx     *ISPAuthContext          // Provider's authentication context (in-memory profile)
+	client      *isp.ArkISPServiceClient // SDK's authenticated HTTP client
+	retryConfig *RetryConfig             // Retry settings for API calls (nil uses DefaultRetryConfig)
 }
 
 // NewCertificatesClient creates a new certificates client using ARK SDK authentication.
@@ -85,6 +87,17 @@ func NewCertificatesClient(ctx context.Context, authCtx *ISPAuthContext) (*Certi
 	return certsClient, nil
 }
 
+// NewCertificatesClientWithISPClient creates a certificates client around an existing ISP service client.
+// No token refresh is configured; the caller owns the client's authentication.
+func NewCertificatesClientWithISPClient(client *isp.ArkISPServiceClient) *CertificatesClient {
+	return &CertificatesClient{client: client}
+}
+
+// SetRetryConfig sets the retry settings used for all certificate API calls
+func (c *CertificatesClient) SetRetryConfig(config *RetryConfig) {
+	c.retryConfig = config
+}
+
 // refreshSIAAuth refreshes the authentication token when it expires.
 // Called automatically by SDK when token approaches 15-min expiration.
 // CRITICAL: Re-authenticates with in-memory profile to bypass cache
@@ -344,11 +357,7 @@ func (c *CertificatesClient) CreateCertificate(ctx context.Context, req *Certifi
 
 	// Execute POST request with retry logic
 	var cert Certificate
-	err := RetryWithBackoff(ctx, &RetryConfig{
-		MaxRetries: DefaultMaxRetries,
-		BaseDelay:  BaseDelay,
-		MaxDelay:   MaxDelay,
-	}, func() error {
+	err := RetryWithBackoff(ctx, c.retryConfig, func() error {
 		// POST request using SDK client (auto-handles auth headers)
 		response, postErr := c.client.Post(ctx, certificatesURL, requestMap)
 		if postErr != nil {
@@ -425,37 +434,48 @@ func (c *CertificatesClient) GetCertificate(ctx context.Context, id string) (*Ce
 	// Construct endpoint URL
 	url := fmt.Sprintf(certificateURL, id)
 
-	// Execute GET request
-	response, err := c.client.Get(ctx, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get certificate %s: %w", id, err)
-	}
-	defer response.Body.Close()
+	// Execute GET request with retry logic
+	var cert *Certificate
+	err := RetryWithBackoff(ctx, c.retryConfig, func() error {
+		response, getErr := c.client.Get(ctx, url, nil)
+		if getErr != nil {
+			return fmt.Errorf("failed to get certificate %s: %w", id, getErr)
+		}
+		defer response.Body.Close()
 
-	// Handle HTTP status codes
-	if response.StatusCode == http.StatusNotFound {
-		// Return nil for drift detection (resource removed externally)
-		return nil, nil
-	}
+		// Handle HTTP status codes
+		if response.StatusCode == http.StatusNotFound {
+			// Return nil for drift detection (resource removed externally)
+			cert = nil
+			return nil
+		}
 
-	if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get certificate %s - [%d] - [%s]",
-			id, response.StatusCode, common.SerializeResponseToJSON(response.Body))
-	}
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to get certificate %s - [%d] - [%s]",
+				id, response.StatusCode, common.SerializeResponseToJSON(response.Body))
+		}
 
-	// Deserialize JSON response (SAME as WorkspacesDB line 324-327)
-	certJSON, err := common.DeserializeJSONSnake(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize GET response: %w", err)
-	}
+		// Deserialize JSON response (SAME as WorkspacesDB line 324-327)
+		certJSON, err := common.DeserializeJSONSnake(response.Body)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize GET response: %w", err)
+		}
 
-	// Convert to Certificate struct
-	var cert Certificate
-	if err := mapstructure.Decode(certJSON, &cert); err != nil {
-		return nil, fmt.Errorf("failed to decode GET response: %w", err)
+		// Convert to Certificate struct
+		var decoded Certificate
+		if err := mapstructure.Decode(certJSON, &decoded); err != nil {
+			return fmt.Errorf("failed to decode GET response: %w", err)
+		}
+
+		cert = &decoded
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
-	return &cert, nil
+	return cert, nil
 }
 
 // UpdateCertificate updates an existing certificate in SIA.
@@ -498,11 +518,7 @@ func (c *CertificatesClient) UpdateCertificate(ctx context.Context, id string, r
 
 	// Execute PUT request with retry logic
 	var cert Certificate
-	err := RetryWithBackoff(ctx, &RetryConfig{
-		MaxRetries: DefaultMaxRetries,
-		BaseDelay:  BaseDelay,
-		MaxDelay:   MaxDelay,
-	}, func() error {
+	err := RetryWithBackoff(ctx, c.retryConfig, func() error {
 		// PUT request using SDK client (auto-handles auth headers)
 		response, putErr := c.client.Put(ctx, url, requestMap)
 		if putErr != nil {
@@ -570,26 +586,58 @@ func (c *CertificatesClient) DeleteCertificate(ctx context.Context, certificateI
 
 	endpoint := fmt.Sprintf(certificateURL, certificateID)
 
-	// Execute DELETE request
-	// NOTE: SDK bug - passing nil causes panic. Pass empty map as workaround.
-	response, err := c.client.Delete(ctx, endpoint, map[string]string{})
-	if err != nil {
-		return fmt.Errorf("failed to delete certificate %s: %w", certificateID, err)
-	}
-	defer response.Body.Close()
+	// Execute DELETE request with retry logic
+	// 409 Conflict (certificate in use) is deterministic and is never retried
+	return RetryWithBackoff(ctx, c.retryConfig, func() error {
+		// NOTE: SDK bug - passing nil causes panic. Pass empty map as workaround.
+		response, err := c.client.Delete(ctx, endpoint, map[string]string{})
+		if err != nil {
+			return fmt.Errorf("failed to delete certificate %s: %w", certificateID, err)
+		}
+		defer response.Body.Close()
+
+		// Handle HTTP status codes (SAME as WorkspacesDB line 191-198)
+		if response.StatusCode == http.StatusNotFound {
+			// Treat as success (already deleted)
+			return nil
+		}
+
+		if response.StatusCode == http.StatusConflict {
+			return certificateInUseError(certificateID, response.Body)
+		}
+
+		if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+			return fmt.Errorf("failed to delete certificate %s - [%d] - [%s]",
+				certificateID, response.StatusCode, common.SerializeResponseToJSON(response.Body))
+		}
 
-	// Handle HTTP status codes (SAME as WorkspacesDB line 191-198)
-	if response.StatusCode == http.StatusNotFound {
-		// Treat as success (already deleted)
 		return nil
+	})
+}
+
+// certificateInUseResponse is the 409 Conflict body returned when deleting a referenced certificate
+type certificateInUseResponse struct {
+	DependentWorkspaces []string `json:"dependent_workspaces"`
+	Message             string   `json:"message"`
+}
+
+// certificateInUseError builds the delete error for a 409 Conflict, listing the dependent
+// workspace IDs when the API provides them so MapCertificateError can surface them to the user
+func certificateInUseError(certificateID string, body io.Reader) error {
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("failed to delete certificate %s - [%d] - failed to read response: %w",
+			certificateID, http.StatusConflict, err)
 	}
 
-	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+	var conflict certificateInUseResponse
+	if err := json.Unmarshal(raw, &conflict); err != nil || len(conflict.DependentWorkspaces) == 0 {
 		return fmt.Errorf("failed to delete certificate %s - [%d] - [%s]",
-			certificateID, response.StatusCode, common.SerializeResponseToJSON(response.Body))
+			certificateID, http.StatusConflict, strings.TrimSpace(string(raw)))
 	}
 
-	return nil
+	return fmt.Errorf("failed to delete certificate %s - [%d] - certificate in use by database workspaces: %s (%s)",
+		certificateID, http.StatusConflict, strings.Join(conflict.DependentWorkspaces, ", "), conflict.Message)
 }
 
 // ListCertificates retrieves all certificates from SIA.
@@ -620,32 +668,40 @@ func (c *CertificatesClient) DeleteCertificate(ctx context.Context, certificateI
 //   - []CertificateListItem: Array of certificates (empty if none exist)
 //   - error: nil on success, error on failure
 func (c *CertificatesClient) ListCertificates(ctx context.Context) ([]CertificateListItem, error) {
-	// Execute GET request (SAME as WorkspacesDB line 89)
-	httpResponse, err := c.client.Get(ctx, certificatesURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list certificates: %w", err)
-	}
-	defer httpResponse.Body.Close()
-
-	// Check HTTP status code
-	if httpResponse.StatusCode != http.StatusOK {
-		// Best-effort error response body read for debugging
-		// Intentionally ignoring error - already in error path
-		bodyBytes, _ := io.ReadAll(httpResponse.Body) //nolint:errcheck
-		return nil, fmt.Errorf("failed to list certificates - [%d] - [%s]",
-			httpResponse.StatusCode, string(bodyBytes))
-	}
+	// Execute GET request with retry logic (SAME as WorkspacesDB line 89)
+	var response CertificateListResponse
+	err := RetryWithBackoff(ctx, c.retryConfig, func() error {
+		httpResponse, getErr := c.client.Get(ctx, certificatesURL, nil)
+		if getErr != nil {
+			return fmt.Errorf("failed to list certificates: %w", getErr)
+		}
+		defer httpResponse.Body.Close()
 
-	// Deserialize JSON response (SAME as WorkspacesDB line 96-99)
-	listJSON, err := common.DeserializeJSONSnake(httpResponse.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize LIST response: %w", err)
-	}
+		// Check HTTP status code
+		if httpResponse.StatusCode != http.StatusOK {
+			// Best-effort error response body read for debugging
+			// Intentionally ignoring error - already in error path
+			bodyBytes, _ := io.ReadAll(httpResponse.Body) //nolint:errcheck
+			return fmt.Errorf("failed to list certificates - [%d] - [%s]",
+				httpResponse.StatusCode, string(bodyBytes))
+		}
 
-	// Convert to CertificateListResponse struct
-	var response CertificateListResponse
-	if err := mapstructure.Decode(listJSON, &response); err != nil {
-		return nil, fmt.Errorf("failed to decode LIST response: %w", err)
+		// Deserialize JSON response (SAME as WorkspacesDB line 96-99)
+		listJSON, err := common.DeserializeJSONSnake(httpResponse.Body)
+		if err != nil {
+			return fmt.Errorf("failed to deserialize LIST response: %w", err)
+		}
+
+		// Convert to CertificateListResponse struct
+		if err := mapstructure.Decode(listJSON, &response); err != nil {
+			return fmt.Errorf("failed to decode LIST response: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
 	// Handle nested response structure
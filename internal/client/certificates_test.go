@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cyberark/ark-sdk-golang/pkg/common"
+	"github.com/cyberark/ark-sdk-golang/pkg/common/isp"
+)
+
+// newTestCertificatesClient returns a CertificatesClient backed by a TLS test server
+// that replies with the given status codes in order (the last one repeats).
+func newTestCertificatesClient(t *testing.T, body string, statuses ...int) (*CertificatesClient, *int32) {
+	t.Helper()
+
+	var calls int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		status := statuses[len(statuses)-1]
+		if n <= len(statuses) {
+			status = statuses[n-1]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body)) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	// SDK builds its own transport per request; skip verification of the self-signed test cert
+	t.Setenv(common.ArkDisableCertificateVerificationEnvVar, "true")
+
+	// SDK prepends https:// to the base URL, so pass host:port only
+	baseURL := strings.TrimPrefix(server.URL, "https://")
+	return &CertificatesClient{
+		client: &isp.ArkISPServiceClient{ArkClient: common.NewSimpleArkClient(baseURL)},
+	}, &calls
+}
+
+func TestGetCertificate_RetriesTransientErrors(t *testing.T) {
+	c, calls := newTestCertificatesClient(t,
+		`{"certificate_id": "123", "cert_name": "test-cert"}`,
+		http.StatusServiceUnavailable, http.StatusOK)
+
+	cert, err := c.GetCertificate(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("GetCertificate() unexpected error: %v", err)
+	}
+	if cert == nil || cert.CertificateID != "123" {
+		t.Errorf("GetCertificate() = %+v, want certificate 123", cert)
+	}
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestCertificatesClient_SetRetryConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *RetryConfig
+		wantCalls int32
+	}{
+		{
+			name:      "status code not configured is not retried",
+			config:    &RetryConfig{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryableStatusCodes: []int{429}},
+			wantCalls: 1,
+		},
+		{
+			name:      "max_retries bounds the attempts",
+			config:    &RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, RetryableStatusCodes: []int{503}},
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, calls := newTestCertificatesClient(t, `{}`, http.StatusServiceUnavailable)
+			c.SetRetryConfig(tt.config)
+
+			if _, err := c.ListCertificates(context.Background()); err == nil {
+				t.Fatal("ListCertificates() expected error for 503")
+			}
+			if got := atomic.LoadInt32(calls); got != tt.wantCalls {
+				t.Errorf("expected %d attempts, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestGetCertificate_NotFoundNotRetried(t *testing.T) {
+	c, calls := newTestCertificatesClient(t, `{}`, http.StatusNotFound)
+
+	cert, err := c.GetCertificate(context.Background(), "123")
+	if err != nil {
+		t.Fatalf("GetCertificate() unexpected error: %v", err)
+	}
+	if cert != nil {
+		t.Errorf("GetCertificate() = %+v, want nil for 404", cert)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestListCertificates_RetriesTransientErrors(t *testing.T) {
+	c, calls := newTestCertificatesClient(t,
+		`{"certificates": {"items": [{"certificate_id": "123", "cert_name": "test-cert"}]}}`,
+		http.StatusBadGateway, http.StatusOK)
+
+	if _, err := c.ListCertificates(context.Background()); err != nil {
+		t.Fatalf("ListCertificates() unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestListCertificates_FieldMapping(t *testing.T) {
+	c, _ := newTestCertificatesClient(t, `{
+		"tenant_id": "tenant-1",
+		"certificates": {
+			"items": [{
+				"certificate_id": "1234567890123456",
+				"cert_name": "internal-ca",
+				"cert_description": "Internal CA",
+				"body": "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+				"domain": "db.example.com",
+				"expiration_date": "2030-01-01T00:00:00Z",
+				"labels": {"env": "prod"},
+				"metadata": {"issuer": "CN=Internal CA", "serial_number": "01"}
+			}]
+		}
+	}`, http.StatusOK)
+
+	items, err := c.ListCertificates(context.Background())
+	if err != nil {
+		t.Fatalf("ListCertificates() unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 certificate, got %d", len(items))
+	}
+
+	item := items[0]
+	if item.Body != "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----" {
+		t.Errorf("Body = %q, want PEM body from \"body\"", item.Body)
+	}
+	if item.Domain != "db.example.com" {
+		t.Errorf("Domain = %q, want %q from \"domain\"", item.Domain, "db.example.com")
+	}
+	if item.CertificateID != "1234567890123456" {
+		t.Errorf("CertificateID = %q", item.CertificateID)
+	}
+	if item.CertName != "internal-ca" || item.CertDescription != "Internal CA" {
+		t.Errorf("CertName/CertDescription = %q/%q", item.CertName, item.CertDescription)
+	}
+	if item.ExpirationDate != "2030-01-01T00:00:00Z" {
+		t.Errorf("ExpirationDate = %q", item.ExpirationDate)
+	}
+	if item.Labels["env"] != "prod" {
+		t.Errorf("Labels = %v", item.Labels)
+	}
+	if item.Metadata == nil || item.Metadata.Issuer != "CN=Internal CA" {
+		t.Errorf("Metadata = %+v", item.Metadata)
+	}
+}
+
+func TestListCertificates_Empty(t *testing.T) {
+	c, _ := newTestCertificatesClient(t, `{"tenant_id": "tenant-1"}`, http.StatusOK)
+
+	items, err := c.ListCertificates(context.Background())
+	if err != nil {
+		t.Fatalf("ListCertificates() unexpected error: %v", err)
+	}
+	if items == nil || len(items) != 0 {
+		t.Errorf("ListCertificates() = %v, want empty non-nil slice", items)
+	}
+}
+
+func TestDeleteCertificate_RetriesTransientErrors(t *testing.T) {
+	c, calls := newTestCertificatesClient(t, ``,
+		http.StatusServiceUnavailable, http.StatusNoContent)
+
+	if err := c.DeleteCertificate(context.Background(), "123"); err != nil {
+		t.Fatalf("DeleteCertificate() unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestDeleteCertificate_ConflictNotRetried(t *testing.T) {
+	c, calls := newTestCertificatesClient(t,
+		`{"code": "CERTIFICATE_IN_USE", "message": "certificate is in use"}`,
+		http.StatusConflict)
+
+	err := c.DeleteCertificate(context.Background(), "123")
+	if err == nil {
+		t.Fatal("DeleteCertificate() expected error for 409 conflict")
+	}
+	if !strings.Contains(err.Error(), "409") {
+		t.Errorf("DeleteCertificate() error = %v, want status 409", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("expected 1 attempt for 409 conflict, got %d", got)
+	}
+}
+
+func TestDeleteCertificate_ConflictListsDependentWorkspaces(t *testing.T) {
+	c, _ := newTestCertificatesClient(t,
+		`{"dependent_workspaces": ["123", "456"], "message": "Certificate is in use"}`,
+		http.StatusConflict)
+
+	err := c.DeleteCertificate(context.Background(), "1761251731882561")
+	if err == nil {
+		t.Fatal("DeleteCertificate() expected error for 409 conflict")
+	}
+	for _, want := range []string{"123", "456", "certificate in use"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("DeleteCertificate() error = %q, want it to contain %q", err, want)
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -53,9 +56,49 @@ func (ec ErrorCategory) String() string {
 	}
 }
 
+// sdkStatusCodePattern matches the status code segment of ARK SDK service errors, which are
+// formatted as "failed to <operation> - [<status>] - [<body>]" (certificates client uses the same form)
+var sdkStatusCodePattern = regexp.MustCompile(` - \[(\d{3})\] - `)
+
+// statusCodeFromError extracts the HTTP status code from an ARK SDK formatted error message
+// Only the first match is used so status-like text in the response body is ignored
+func statusCodeFromError(err error) (int, bool) {
+	match := sdkStatusCodePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0, false
+	}
+	code, convErr := strconv.Atoi(match[1])
+	if convErr != nil {
+		return 0, false
+	}
+	return code, true
+}
+
+// categoryFromStatusCode maps an HTTP status code to an error category
+func categoryFromStatusCode(code int) ErrorCategory {
+	switch {
+	case code == http.StatusUnauthorized:
+		return ErrorCategoryAuth
+	case code == http.StatusForbidden:
+		return ErrorCategoryPermission
+	case code == http.StatusNotFound:
+		return ErrorCategoryNotFound
+	case code == http.StatusConflict:
+		return ErrorCategoryConflict
+	case code == http.StatusTooManyRequests:
+		return ErrorCategoryRateLimit
+	case code == http.StatusBadRequest || code == http.StatusUnprocessableEntity:
+		return ErrorCategoryValidation
+	case code >= 500:
+		return ErrorCategoryServer
+	default:
+		return ErrorCategoryUnknown
+	}
+}
+
 // classifyError determines the error category using multiple detection strategies
-// Note: ARK SDK v1.5.0 does not expose structured error types or HTTP status codes,
-// so we rely on error message patterns and standard Go error type detection
+// Note: ARK SDK v1.5.0 does not expose structured error types, but its service errors embed
+// the HTTP status code in a fixed format which is checked before falling back to message patterns
 func classifyError(err error) ErrorCategory {
 	if err == nil {
 		return ErrorCategoryUnknown
@@ -85,7 +128,14 @@ func classifyError(err error) ErrorCategory {
 		return ErrorCategoryNetwork
 	}
 
-	// 2. Pattern matching (ordered by specificity - most specific first)
+	// 2. Status code embedded by the ARK SDK ("- [404] -")
+	if code, ok := statusCodeFromError(err); ok {
+		if category := categoryFromStatusCode(code); category != ErrorCategoryUnknown {
+			return category
+		}
+	}
+
+	// 3. Pattern matching (ordered by specificity - most specific first)
 
 	// Authentication (very specific patterns first)
 	if strings.Contains(errorMsg, "authentication failed") ||
@@ -116,6 +166,11 @@ func classifyError(err error) ErrorCategory {
 		return ErrorCategoryRateLimit
 	}
 
+	// DNS failures read "no such host" and must not be mistaken for a deleted resource
+	if strings.Contains(errorMsg, "no such host") {
+		return ErrorCategoryNetwork
+	}
+
 	// Resource not found (404)
 	if strings.Contains(errorMsg, "not found") ||
 		strings.Contains(errorMsg, "404") ||
@@ -165,17 +220,17 @@ func classifyError(err error) ErrorCategory {
 		strings.Contains(errorMsg, "timed out") ||
 		strings.Contains(errorMsg, "network") ||
 		strings.Contains(errorMsg, "dial") ||
-		strings.Contains(errorMsg, "no such host") ||
 		strings.Contains(errorMsg, "connection reset") {
 		return ErrorCategoryNetwork
 	}
 
-	// 3. Fallback for unknown errors
+	// 4. Fallback for unknown errors
 	return ErrorCategoryUnknown
 }
 
 // IsNotFoundError returns true if the error represents a 404 Not Found response
-// Used for drift detection in Read() methods to determine if resource was deleted
+// Used for drift detection in Read() methods to determine if resource was deleted.
+// An SDK status code other than 404 always takes precedence over "not found" text in the body.
 func IsNotFoundError(err error) bool {
 	if err == nil {
 		return false
@@ -187,7 +242,8 @@ func IsNotFoundError(err error) bool {
 // Returns nil if err is nil (caller should check before appending)
 //
 // Note: ARK SDK v1.5.0 does not provide structured error types with HTTP status codes.
-// Error classification relies on standard Go error types and string pattern matching.
+// Error classification relies on standard Go error types, the SDK's "- [status] -" message
+// format, and string pattern matching.
 // For robustness, all patterns are ordered by specificity with comprehensive fallback.
 func MapError(err error, operation string) diag.Diagnostic {
 	if err == nil {
@@ -267,7 +323,7 @@ func MapError(err error, operation string) diag.Diagnostic {
 				"Error: %s\n\n"+
 				"Recommended actions:\n"+
 				"1. Check network latency to SIA API\n"+
-				"2. Increase request_timeout in provider configuration\n"+
+				"2. Increase the operation timeout in the resource's timeouts block\n"+
 				"3. Verify SIA API is responsive", errorMsg),
 		)
 
@@ -355,3 +355,67 @@ func TestMapError_WrappedErrors(t *testing.T) {
 		t.Errorf("wrapped error not classified correctly, got summary: %q", diag.Summary())
 	}
 }
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := []struct {
+		err  error
+		name string
+		want bool
+	}{
+		// Should be treated as not found
+		{name: "sdk get database 404", err: errors.New("failed to get database - [404] - [{\"message\":\"Database not found\"}]"), want: true},
+		{name: "sdk get policy 404 empty body", err: errors.New("failed to get policy - [404] - []"), want: true},
+		{name: "sdk 404 wrapped", err: fmt.Errorf("read database workspace: %w", errors.New("failed to get database - [404] - [{}]")), want: true},
+		{name: "certificates client 404", err: errors.New("failed to delete certificate 123 - [404] - not found"), want: true},
+		{name: "plain not found", err: errors.New("resource not found"), want: true},
+		{name: "http 404 text", err: errors.New("HTTP 404 not found"), want: true},
+		{name: "does not exist", err: errors.New("policy does not exist"), want: true},
+		{name: "no such resource", err: errors.New("no such secret"), want: true},
+
+		// Should not be treated as not found
+		{name: "nil", err: nil, want: false},
+		{name: "sdk 500 with not found in body", err: errors.New("failed to get database - [500] - [{\"message\":\"upstream record not found\"}]"), want: false},
+		{name: "sdk 409 with 404 in body", err: errors.New("failed to update policy - [409] - [{\"code\":\"ERR_404_CONFLICT\"}]"), want: false},
+		{name: "sdk 401", err: errors.New("failed to list databases - [401] - [Unauthorized]"), want: false},
+		{name: "sdk 403", err: errors.New("failed to get secret - [403] - [Forbidden]"), want: false},
+		{name: "dns lookup failure", err: errors.New("dial tcp: lookup abc.dpa.cyberark.cloud: no such host"), want: false},
+		{name: "server error", err: errors.New("internal server error"), want: false},
+		{name: "validation error", err: errors.New("validation failed: name is required"), want: false},
+		{name: "context deadline", err: context.DeadlineExceeded, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFoundError(tt.err); got != tt.want {
+				t.Errorf("IsNotFoundError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyError_SDKStatusCode(t *testing.T) {
+	tests := []struct {
+		err      error
+		name     string
+		expected ErrorCategory
+	}{
+		{name: "400", err: errors.New("failed to add database - [400] - [bad field]"), expected: ErrorCategoryValidation},
+		{name: "401", err: errors.New("failed to add database - [401] - []"), expected: ErrorCategoryAuth},
+		{name: "403", err: errors.New("failed to add database - [403] - []"), expected: ErrorCategoryPermission},
+		{name: "404", err: errors.New("failed to get database - [404] - []"), expected: ErrorCategoryNotFound},
+		{name: "409", err: errors.New("failed to add database - [409] - []"), expected: ErrorCategoryConflict},
+		{name: "422", err: errors.New("failed to add database - [422] - []"), expected: ErrorCategoryValidation},
+		{name: "429", err: errors.New("failed to add database - [429] - []"), expected: ErrorCategoryRateLimit},
+		{name: "502", err: errors.New("failed to add database - [502] - []"), expected: ErrorCategoryServer},
+		{name: "504", err: errors.New("failed to add database - [504] - []"), expected: ErrorCategoryServer},
+		{name: "unmapped status falls back to patterns", err: errors.New("failed to add database - [302] - [already exists]"), expected: ErrorCategoryConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyError(tt.err); got != tt.expected {
+				t.Errorf("classifyError(%q) = %v, want %v", tt.err.Error(), got, tt.expected)
+			}
+		})
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"net"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,24 +20,56 @@ const (
 	DefaultMaxRetries = 3
 	// BaseDelay is the base delay for exponential backoff (500ms)
 	BaseDelay = 500 * time.Millisecond
-	// MaxDelay is the maximum delay between retries (30s)
-	MaxDelay = 30 * time.Second
+	// MaxDelay is the maximum delay between retries (10s)
+	MaxDelay = 10 * time.Second
+	// DefaultJitterFraction randomizes each delay by ±10% so concurrent applies don't retry in lockstep
+	DefaultJitterFraction = 0.1
 )
 
 // RetryConfig holds retry configuration
 type RetryConfig struct {
-	MaxRetries int64
-	BaseDelay  time.Duration
-	MaxDelay   time.Duration
+	MaxRetries     int64
+	BaseDelay      time.Duration
+	MaxDelay       time.Duration
+	JitterFraction float64 // Fraction of each delay randomized in either direction (0 disables jitter)
+
+	// RetryableStatusCodes decides retries for errors carrying an HTTP status code; an empty,
+	// non-nil list retries no status codes. Errors without one, or any error when nil,
+	// fall back to IsRetryable.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryableStatusCodes returns the HTTP status codes retried by default
+// (rate limiting and transient server errors)
+func DefaultRetryableStatusCodes() []int {
+	return []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
 }
 
 // DefaultRetryConfig returns default retry configuration
 func DefaultRetryConfig() *RetryConfig {
 	return &RetryConfig{
-		MaxRetries: DefaultMaxRetries,
-		BaseDelay:  BaseDelay,
-		MaxDelay:   MaxDelay,
+		MaxRetries:           DefaultMaxRetries,
+		BaseDelay:            BaseDelay,
+		MaxDelay:             MaxDelay,
+		JitterFraction:       DefaultJitterFraction,
+		RetryableStatusCodes: DefaultRetryableStatusCodes(),
+	}
+}
+
+// shouldRetry reports whether err is worth another attempt under this configuration
+func (c *RetryConfig) shouldRetry(err error) bool {
+	if c.RetryableStatusCodes != nil {
+		if code, ok := statusCodeFromError(err); ok {
+			return slices.Contains(c.RetryableStatusCodes, code)
+		}
 	}
+	return IsRetryable(err)
 }
 
 // RetryableOperation is a function that can be retried
@@ -111,6 +146,12 @@ func IsRetryable(err error) bool {
 		return false
 	}
 
+	// Never retry conflicts - duplicate names and in-use resources are deterministic
+	if strings.Contains(errorMsg, "409") ||
+		strings.Contains(errorMsg, "conflict") {
+		return false
+	}
+
 	// Retry on rate limiting (after backoff delay)
 	if strings.Contains(errorMsg, "rate limit") ||
 		strings.Contains(errorMsg, "too many requests") ||
@@ -165,7 +206,7 @@ func RetryWithBackoff(ctx context.Context, config *RetryConfig, operation Retrya
 		lastErr = err
 
 		// Check if error is retryable
-		if !IsRetryable(err) {
+		if !config.shouldRetry(err) {
 			tflog.Debug(ctx, "Error is not retryable, failing immediately", map[string]interface{}{
 				"error": err.Error(),
 			})
@@ -182,11 +223,7 @@ func RetryWithBackoff(ctx context.Context, config *RetryConfig, operation Retrya
 			break
 		}
 
-		// Calculate exponential backoff delay
-		delay := config.BaseDelay * time.Duration(1<<attempt)
-		if delay > config.MaxDelay {
-			delay = config.MaxDelay
-		}
+		delay := backoffDelay(config, attempt)
 
 		// Log retry attempt with backoff info
 		tflog.Warn(ctx, "Retrying operation after transient failure", map[string]interface{}{
@@ -210,3 +247,23 @@ func RetryWithBackoff(ctx context.Context, config *RetryConfig, operation Retrya
 
 	return fmt.Errorf("max retries (%d) exceeded: %w", config.MaxRetries, lastErr)
 }
+
+// backoffDelay calculates the exponential backoff delay before the next attempt,
+// applying jitter and capping the result at MaxDelay
+func backoffDelay(config *RetryConfig, attempt int64) time.Duration {
+	delay := config.BaseDelay * time.Duration(1<<attempt)
+	if delay > config.MaxDelay {
+		delay = config.MaxDelay
+	}
+
+	if config.JitterFraction > 0 {
+		// Scale by a random factor in [1-JitterFraction, 1+JitterFraction)
+		factor := 1 + config.JitterFraction*(2*rand.Float64()-1)
+		delay = time.Duration(float64(delay) * factor)
+		if delay > config.MaxDelay {
+			delay = config.MaxDelay
+		}
+	}
+
+	return delay
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 )
@@ -78,6 +79,16 @@ func TestIsRetryable(t *testing.T) {
 			err:      errors.New("HTTP 422 unprocessable entity"),
 			expected: false,
 		},
+		{
+			name:     "conflict 409",
+			err:      errors.New("HTTP 409 conflict"),
+			expected: false,
+		},
+		{
+			name:     "conflict 409 with 5xx text in body",
+			err:      errors.New("failed to delete certificate 123 - [409] - [certificate in use, retry after 503]"),
+			expected: false,
+		},
 
 		// Retryable errors
 		{
@@ -329,6 +340,176 @@ func TestDefaultRetryConfig(t *testing.T) {
 	if config.MaxDelay != MaxDelay {
 		t.Errorf("DefaultRetryConfig().MaxDelay = %v, want %v", config.MaxDelay, MaxDelay)
 	}
+	if config.JitterFraction != DefaultJitterFraction {
+		t.Errorf("DefaultRetryConfig().JitterFraction = %v, want %v", config.JitterFraction, DefaultJitterFraction)
+	}
+	if !slices.Equal(config.RetryableStatusCodes, []int{429, 500, 502, 503, 504}) {
+		t.Errorf("DefaultRetryConfig().RetryableStatusCodes = %v, want [429 500 502 503 504]", config.RetryableStatusCodes)
+	}
+}
+
+func TestRetryConfig_shouldRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		codes  []int
+		err    error
+		expect bool
+	}{
+		{
+			name:   "status code in list",
+			codes:  []int{429},
+			err:    errors.New("failed to list policies - [429] - [slow down]"),
+			expect: true,
+		},
+		{
+			name:   "server error not in list",
+			codes:  []int{429},
+			err:    errors.New("failed to list policies - [503] - [unavailable]"),
+			expect: false,
+		},
+		{
+			name:   "custom code retried",
+			codes:  []int{409},
+			err:    errors.New("failed to update policy - [409] - [locked]"),
+			expect: true,
+		},
+		{
+			name:   "no status code falls back to IsRetryable",
+			codes:  []int{429},
+			err:    errors.New("connection refused"),
+			expect: true,
+		},
+		{
+			name:   "nil list uses IsRetryable for coded errors",
+			err:    errors.New("failed to list policies - [503] - [unavailable]"),
+			expect: true,
+		},
+		{
+			name:   "empty list retries no status codes",
+			codes:  []int{},
+			err:    errors.New("failed to list policies - [503] - [unavailable]"),
+			expect: false,
+		},
+		{
+			name:   "empty list still retries errors without a status code",
+			codes:  []int{},
+			err:    errors.New("connection refused"),
+			expect: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &RetryConfig{RetryableStatusCodes: tt.codes}
+			if got := config.shouldRetry(tt.err); got != tt.expect {
+				t.Errorf("shouldRetry(%q) with codes %v = %v, want %v", tt.err, tt.codes, got, tt.expect)
+			}
+		})
+	}
+}
+
+func TestBackoffDelay_JitterWithinFraction(t *testing.T) {
+	config := &RetryConfig{
+		BaseDelay:      100 * time.Millisecond,
+		MaxDelay:       time.Second,
+		JitterFraction: 0.1,
+	}
+
+	for attempt := int64(0); attempt < 3; attempt++ {
+		base := config.BaseDelay * time.Duration(1<<attempt)
+		low := time.Duration(float64(base) * (1 - config.JitterFraction))
+		high := time.Duration(float64(base) * (1 + config.JitterFraction))
+
+		for i := 0; i < 1000; i++ {
+			delay := backoffDelay(config, attempt)
+			if delay < low || delay > high {
+				t.Fatalf("backoffDelay(attempt=%d) = %v, want within [%v, %v]", attempt, delay, low, high)
+			}
+		}
+	}
+}
+
+func TestBackoffDelay_JitterCappedAtMaxDelay(t *testing.T) {
+	config := &RetryConfig{
+		BaseDelay:      10 * time.Millisecond,
+		MaxDelay:       50 * time.Millisecond,
+		JitterFraction: 0.5,
+	}
+
+	for i := 0; i < 1000; i++ {
+		if delay := backoffDelay(config, 10); delay > config.MaxDelay {
+			t.Fatalf("backoffDelay() = %v exceeds MaxDelay %v", delay, config.MaxDelay)
+		}
+	}
+}
+
+func TestBackoffDelay_NoJitter(t *testing.T) {
+	config := &RetryConfig{
+		BaseDelay: 10 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	for attempt, want := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond} {
+		if got := backoffDelay(config, int64(attempt)); got != want {
+			t.Errorf("backoffDelay(attempt=%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestRetryWithBackoff_JitteredDelaysIncrease(t *testing.T) {
+	config := &RetryConfig{
+		MaxRetries:     3,
+		BaseDelay:      20 * time.Millisecond,
+		MaxDelay:       time.Second,
+		JitterFraction: 0.1,
+	}
+
+	var callTimes []time.Time
+	operation := func() error {
+		callTimes = append(callTimes, time.Now())
+		return errors.New("HTTP 503 service unavailable")
+	}
+
+	// Test validates call spacing, not operation success
+	_ = RetryWithBackoff(context.Background(), config, operation) //nolint:errcheck
+
+	if len(callTimes) != 4 {
+		t.Fatalf("expected 4 attempts, got %d", len(callTimes))
+	}
+	for i := 1; i < len(callTimes); i++ {
+		gap := callTimes[i].Sub(callTimes[i-1])
+		minGap := time.Duration(float64(config.BaseDelay*time.Duration(1<<(i-1))) * (1 - config.JitterFraction))
+		if gap < minGap {
+			t.Errorf("gap before attempt %d = %v, want at least %v", i+1, gap, minGap)
+		}
+	}
+	// Doubling base delay outgrows ±10% jitter, so gaps must strictly increase
+	for i := 2; i < len(callTimes); i++ {
+		if prev, cur := callTimes[i-1].Sub(callTimes[i-2]), callTimes[i].Sub(callTimes[i-1]); cur <= prev {
+			t.Errorf("gap before attempt %d (%v) not greater than previous gap (%v)", i+1, cur, prev)
+		}
+	}
+}
+
+func TestRetryWithBackoff_ZeroMaxRetries(t *testing.T) {
+	config := &RetryConfig{
+		MaxRetries:     0,
+		BaseDelay:      10 * time.Millisecond,
+		MaxDelay:       100 * time.Millisecond,
+		JitterFraction: DefaultJitterFraction,
+	}
+
+	attempts := 0
+	err := RetryWithBackoff(context.Background(), config, func() error {
+		attempts++
+		return errors.New("HTTP 503 service unavailable")
+	})
+	if err == nil {
+		t.Fatal("expected error after single failed attempt")
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt with MaxRetries = 0, got %d", attempts)
+	}
 }
 
 func TestRetryWithBackoff_NilConfig(t *testing.T) {
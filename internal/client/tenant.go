@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cyberark/ark-sdk-golang/pkg/common/isp"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// siaServiceName is the ISP service name SIA APIs are served under (https://{subdomain}.dpa.{domain})
+const siaServiceName = "dpa"
+
+// TenantInfo describes the CyberArk tenant the provider is authenticated against
+type TenantInfo struct {
+	TenantID  string // Tenant UUID from the tenant_id token claim
+	Subdomain string // Tenant subdomain (e.g., "abc123")
+	Domain    string // Platform domain (e.g., "cyberark.cloud")
+	SIAURL    string // SIA API base URL (e.g., "https://abc123.dpa.cyberark.cloud")
+}
+
+// GetTenantInfo resolves tenant metadata from the authenticated ISP session.
+// The SIA URL is resolved by the SDK exactly as the SIA services resolve their base URL
+// (token subdomain/platform_domain claims), so it always matches the endpoint resources use.
+func GetTenantInfo(ctx context.Context, authCtx *ISPAuthContext) (*TenantInfo, error) {
+	if authCtx == nil || authCtx.ISPAuth == nil || authCtx.ISPAuth.ArkAuthBase == nil || authCtx.ISPAuth.Token == nil {
+		return nil, fmt.Errorf("authentication context is not initialized")
+	}
+
+	tflog.Debug(ctx, "Resolving tenant info from ISP session")
+
+	siaClient, err := isp.FromISPAuth(authCtx.ISPAuth, siaServiceName, ".", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve SIA URL: %w", err)
+	}
+
+	parsedURL, err := url.Parse(siaClient.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SIA URL %q: %w", siaClient.BaseURL, err)
+	}
+	subdomain, domain, found := strings.Cut(parsedURL.Hostname(), "."+siaServiceName+".")
+	if !found {
+		return nil, fmt.Errorf("unexpected SIA URL format: %s", siaClient.BaseURL)
+	}
+
+	token, _, err := new(jwt.Parser).ParseUnverified(authCtx.ISPAuth.Token.Token, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse access token: %w", err)
+	}
+	claims, _ := token.Claims.(jwt.MapClaims)
+	tenantID, _ := claims["tenant_id"].(string)
+	if tenantID == "" {
+		return nil, fmt.Errorf("access token does not contain a tenant_id claim")
+	}
+
+	return &TenantInfo{
+		TenantID:  tenantID,
+		Subdomain: subdomain,
+		Domain:    domain,
+		SIAURL:    siaClient.BaseURL,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/ark-sdk-golang/pkg/auth"
+	authmodels "github.com/cyberark/ark-sdk-golang/pkg/models/auth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// newTestAuthContext returns an auth context holding an unsigned token with the given claims
+func newTestAuthContext(t *testing.T, claims jwt.MapClaims) *ISPAuthContext {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("failed to build test token: %s", err)
+	}
+
+	return &ISPAuthContext{
+		ISPAuth: &auth.ArkISPAuth{
+			ArkAuthBase: &auth.ArkAuthBase{
+				Token: &authmodels.ArkToken{
+					Token:    token,
+					Username: "svc@cyberark.cloud.12345",
+					Metadata: map[string]interface{}{},
+				},
+			},
+		},
+	}
+}
+
+func TestGetTenantInfo(t *testing.T) {
+	authCtx := newTestAuthContext(t, jwt.MapClaims{
+		"tenant_id":       "4f2c7d8e-1a2b-4c3d-9e8f-0a1b2c3d4e5f",
+		"subdomain":       "acme",
+		"platform_domain": "cyberark.cloud",
+	})
+
+	info, err := GetTenantInfo(context.Background(), authCtx)
+	if err != nil {
+		t.Fatalf("GetTenantInfo() error = %v", err)
+	}
+
+	if info.TenantID != "4f2c7d8e-1a2b-4c3d-9e8f-0a1b2c3d4e5f" {
+		t.Errorf("TenantID = %q", info.TenantID)
+	}
+	if info.Subdomain != "acme" {
+		t.Errorf("Subdomain = %q, want %q", info.Subdomain, "acme")
+	}
+	if info.Domain != "cyberark.cloud" {
+		t.Errorf("Domain = %q, want %q", info.Domain, "cyberark.cloud")
+	}
+	if info.SIAURL != "https://acme.dpa.cyberark.cloud" {
+		t.Errorf("SIAURL = %q, want %q", info.SIAURL, "https://acme.dpa.cyberark.cloud")
+	}
+}
+
+func TestGetTenantInfo_ShellPlatformDomain(t *testing.T) {
+	authCtx := newTestAuthContext(t, jwt.MapClaims{
+		"tenant_id":       "tenant-1",
+		"subdomain":       "acme",
+		"platform_domain": "shell.cyberark.cloud",
+	})
+
+	info, err := GetTenantInfo(context.Background(), authCtx)
+	if err != nil {
+		t.Fatalf("GetTenantInfo() error = %v", err)
+	}
+
+	if info.Domain != "cyberark.cloud" {
+		t.Errorf("Domain = %q, want %q", info.Domain, "cyberark.cloud")
+	}
+	if info.SIAURL != "https://acme.dpa.cyberark.cloud" {
+		t.Errorf("SIAURL = %q, want %q", info.SIAURL, "https://acme.dpa.cyberark.cloud")
+	}
+}
+
+func TestGetTenantInfo_MissingTenantID(t *testing.T) {
+	authCtx := newTestAuthContext(t, jwt.MapClaims{
+		"subdomain":       "acme",
+		"platform_domain": "cyberark.cloud",
+	})
+
+	_, err := GetTenantInfo(context.Background(), authCtx)
+	if err == nil || !strings.Contains(err.Error(), "tenant_id") {
+		t.Fatalf("expected tenant_id error, got %v", err)
+	}
+}
+
+func TestGetTenantInfo_NilAuthContext(t *testing.T) {
+	if _, err := GetTenantInfo(context.Background(), nil); err == nil {
+		t.Fatal("expected error for nil auth context")
+	}
+	if _, err := GetTenantInfo(context.Background(), &ISPAuthContext{}); err == nil {
+		t.Fatal("expected error for empty auth context")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
 	uapsiacommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/common/models"
 	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -26,9 +27,9 @@ func ChangeInfoAttrTypes() map[string]attr.Type {
 	return changeInfoAttrTypes
 }
 
-// createChangeInfoObject creates a types.Object from user and timestamp strings
+// ChangeInfoObject creates a types.Object from user and timestamp strings
 // Returns ObjectNull if user is empty, otherwise returns ObjectValue with the provided data
-func createChangeInfoObject(user, timestamp string) types.Object {
+func ChangeInfoObject(user, timestamp string) types.Object {
 	if user == "" {
 		return types.ObjectNull(changeInfoAttrTypes)
 	}
@@ -48,6 +49,19 @@ func createChangeInfoObject(user, timestamp string) types.Object {
 	return objVal
 }
 
+// LastModifiedFromSDK returns the last modification timestamp of a policy: the update
+// timestamp, the creation timestamp if never updated, or null if the API returned neither
+func LastModifiedFromSDK(metadata uapcommonmodels.ArkUAPMetadata) types.String {
+	switch {
+	case metadata.UpdatedOn.Time != "":
+		return types.StringValue(metadata.UpdatedOn.Time)
+	case metadata.CreatedBy.Time != "":
+		return types.StringValue(metadata.CreatedBy.Time)
+	default:
+		return types.StringNull()
+	}
+}
+
 // DatabasePolicyModel represents the Terraform state for cyberarksia_database_policy resource
 type DatabasePolicyModel struct {
 	Conditions               *ConditionsModel                `tfsdk:"conditions"`
@@ -65,6 +79,7 @@ type DatabasePolicyModel struct {
 	LastModified             types.String                    `tfsdk:"last_modified"`
 	Principal                []InlinePrincipalModel          `tfsdk:"principal"`
 	TargetDatabase           []InlineDatabaseAssignmentModel `tfsdk:"target_database"`
+	Timeouts                 timeouts.Value                  `tfsdk:"timeouts"`
 }
 
 // TimeFrameModel represents policy validity period
@@ -163,6 +178,10 @@ func (m *DatabasePolicyModel) ToSDK() *uapsiadbmodels.ArkUAPSIADBAccessPolicy {
 		policy.Conditions = convertConditionsToSDK(m.Conditions)
 	}
 
+	// Convert principals (target_database blocks are built by the resource,
+	// since each target needs its database workspace fetched)
+	policy.Principals = convertPrincipalsToSDK(m.Principal)
+
 	return policy
 }
 
@@ -171,13 +190,15 @@ func (m *DatabasePolicyModel) FromSDK(ctx context.Context, policy *uapsiadbmodel
 	m.ID = types.StringValue(policy.Metadata.PolicyID)
 	m.PolicyID = types.StringValue(policy.Metadata.PolicyID)
 	m.Name = types.StringValue(policy.Metadata.Name)
-	m.Description = types.StringValue(policy.Metadata.Description)
+	// API returns "" for both unset and empty descriptions - keep prior null vs "" to avoid diffs
+	m.Description = emptyStringFromSDK(policy.Metadata.Description, m.Description)
 	// Keep API values as-is (API returns "Active"/"Suspended" capitalized)
 	// Normalize to lowercase to match user config (API returns titlecase)
 	m.Status = types.StringValue(strings.ToLower(policy.Metadata.Status.Status))
 	m.TimeZone = types.StringValue(policy.Metadata.TimeZone)
-	// Normalize to lowercase to match user config (API returns titlecase)
-	m.DelegationClassification = types.StringValue(strings.ToLower(policy.DelegationClassification))
+	// Normalize to lowercase to match user config (API returns titlecase), keeping the
+	// configured casing when it matches so "Unrestricted" in config does not diff forever
+	m.DelegationClassification = caseInsensitiveFromSDK(policy.DelegationClassification, m.DelegationClassification)
 
 	// Convert policy tags
 	if len(policy.Metadata.PolicyTags) > 0 {
@@ -205,13 +226,144 @@ func (m *DatabasePolicyModel) FromSDK(ctx context.Context, policy *uapsiadbmodel
 	// Convert conditions
 	m.Conditions = convertConditionsFromSDK(ctx, &policy.Conditions)
 
+	// Convert principals, keeping the prior block order (target_database blocks are
+	// populated by the resource, since profile parsing is shared with the assignment resource)
+	m.Principal = mergePrincipalsFromSDK(m.Principal, policy.Principals)
+
 	// Computed fields - convert to types.Object to handle unknown values properly
-	m.CreatedBy = createChangeInfoObject(policy.Metadata.CreatedBy.User, policy.Metadata.CreatedBy.Time)
-	m.UpdatedOn = createChangeInfoObject(policy.Metadata.UpdatedOn.User, policy.Metadata.UpdatedOn.Time)
+	m.CreatedBy = ChangeInfoObject(policy.Metadata.CreatedBy.User, policy.Metadata.CreatedBy.Time)
+	m.UpdatedOn = ChangeInfoObject(policy.Metadata.UpdatedOn.User, policy.Metadata.UpdatedOn.Time)
+	m.LastModified = LastModifiedFromSDK(policy.Metadata)
 
 	return nil
 }
 
+// convertPrincipalsToSDK converts inline principal blocks to SDK principals
+func convertPrincipalsToSDK(principals []InlinePrincipalModel) []uapcommonmodels.ArkUAPPrincipal {
+	if len(principals) == 0 {
+		return nil
+	}
+
+	result := make([]uapcommonmodels.ArkUAPPrincipal, len(principals))
+	for i, p := range principals {
+		result[i] = uapcommonmodels.ArkUAPPrincipal{
+			ID:                  p.PrincipalID.ValueString(),
+			Name:                p.PrincipalName.ValueString(),
+			Type:                p.PrincipalType.ValueString(),
+			SourceDirectoryName: p.SourceDirectoryName.ValueString(),
+			SourceDirectoryID:   p.SourceDirectoryID.ValueString(),
+		}
+	}
+
+	return result
+}
+
+// ConvertPrincipalsFromSDK converts SDK principals to inline principal blocks
+func ConvertPrincipalsFromSDK(principals []uapcommonmodels.ArkUAPPrincipal) []InlinePrincipalModel {
+	if len(principals) == 0 {
+		return nil
+	}
+
+	result := make([]InlinePrincipalModel, len(principals))
+	for i, p := range principals {
+		result[i] = InlinePrincipalModel{
+			PrincipalID:   types.StringValue(p.ID),
+			PrincipalType: types.StringValue(p.Type),
+			PrincipalName: types.StringValue(p.Name),
+			// ROLE principals have no source directory - keep null to match config
+			SourceDirectoryName: stringValueOrNull(p.SourceDirectoryName),
+			SourceDirectoryID:   stringValueOrNull(p.SourceDirectoryID),
+		}
+	}
+
+	return result
+}
+
+// mergePrincipalsFromSDK converts SDK principals to inline principal blocks ordered like prior.
+// principal is a list block, so API reordering would otherwise show as a diff. Principals are
+// matched by principal_id; matched blocks keep prior order and prior spelling of values that
+// differ only in case, principals missing from prior are appended in API order, and prior
+// principals missing from the API are dropped
+func mergePrincipalsFromSDK(prior []InlinePrincipalModel, principals []uapcommonmodels.ArkUAPPrincipal) []InlinePrincipalModel {
+	fromAPI := ConvertPrincipalsFromSDK(principals)
+	if len(prior) == 0 || len(fromAPI) == 0 {
+		return fromAPI
+	}
+
+	remaining := make(map[string]int, len(fromAPI))
+	for i, p := range fromAPI {
+		remaining[strings.ToLower(p.PrincipalID.ValueString())] = i
+	}
+
+	result := make([]InlinePrincipalModel, 0, len(fromAPI))
+	for _, p := range prior {
+		key := strings.ToLower(p.PrincipalID.ValueString())
+		i, ok := remaining[key]
+		if !ok {
+			continue
+		}
+		delete(remaining, key)
+
+		api := fromAPI[i]
+		result = append(result, InlinePrincipalModel{
+			PrincipalID:         priorSpellingFromSDK(api.PrincipalID, p.PrincipalID),
+			PrincipalType:       priorSpellingFromSDK(api.PrincipalType, p.PrincipalType),
+			PrincipalName:       priorSpellingFromSDK(api.PrincipalName, p.PrincipalName),
+			SourceDirectoryName: priorSpellingFromSDK(api.SourceDirectoryName, p.SourceDirectoryName),
+			SourceDirectoryID:   priorSpellingFromSDK(api.SourceDirectoryID, p.SourceDirectoryID),
+		})
+	}
+
+	for i, p := range fromAPI {
+		if _, ok := remaining[strings.ToLower(p.PrincipalID.ValueString())]; ok {
+			result = append(result, fromAPI[i])
+		}
+	}
+
+	return result
+}
+
+// priorSpellingFromSDK returns the prior value when it equals the converted API value
+// ignoring case, otherwise the API value
+func priorSpellingFromSDK(value, prior types.String) types.String {
+	if value.IsNull() || prior.IsNull() || prior.IsUnknown() {
+		return value
+	}
+	if strings.EqualFold(prior.ValueString(), value.ValueString()) {
+		return prior
+	}
+	return value
+}
+
+// emptyStringFromSDK returns the API value, or for an empty API value preserves a prior
+// empty string and otherwise returns null
+func emptyStringFromSDK(value string, prior types.String) types.String {
+	if value != "" {
+		return types.StringValue(value)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+	return types.StringNull()
+}
+
+// caseInsensitiveFromSDK returns the prior value when it equals the API value ignoring
+// case, otherwise the lowercased API value
+func caseInsensitiveFromSDK(value string, prior types.String) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && strings.EqualFold(prior.ValueString(), value) {
+		return prior
+	}
+	return types.StringValue(strings.ToLower(value))
+}
+
+// stringValueOrNull returns a null string for empty API values
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
 // convertConditionsToSDK converts Terraform conditions to SDK conditions
 func convertConditionsToSDK(c *ConditionsModel) uapsiacommonmodels.ArkUAPSIACommonConditions {
 	conditions := uapsiacommonmodels.ArkUAPSIACommonConditions{
@@ -2,6 +2,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,6 +24,8 @@ type DatabasePolicyDatabaseAssignmentModel struct {
 	// Computed
 	ID           types.String `tfsdk:"id"`
 	LastModified types.String `tfsdk:"last_modified"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // DBAuthProfileModel represents the db_auth authentication profile
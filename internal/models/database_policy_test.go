@@ -0,0 +1,236 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiacommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/common/models"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTestPolicy builds a minimal SDK policy with the given description
+func newTestPolicy(description string) *uapsiadbmodels.ArkUAPSIADBAccessPolicy {
+	return &uapsiadbmodels.ArkUAPSIADBAccessPolicy{
+		ArkUAPSIACommonAccessPolicy: uapsiacommonmodels.ArkUAPSIACommonAccessPolicy{
+			ArkUAPCommonAccessPolicy: uapcommonmodels.ArkUAPCommonAccessPolicy{
+				Metadata: uapcommonmodels.ArkUAPMetadata{
+					PolicyID:    "policy-123",
+					Name:        "test-policy",
+					Description: description,
+				},
+			},
+		},
+	}
+}
+
+// TestDatabasePolicyModel_FromSDK_Description tests null vs empty description normalization
+func TestDatabasePolicyModel_FromSDK_Description(t *testing.T) {
+	tests := []struct {
+		name        string
+		prior       types.String
+		description string
+		want        types.String
+	}{
+		{
+			name:        "API value set",
+			prior:       types.StringNull(),
+			description: "Production access",
+			want:        types.StringValue("Production access"),
+		},
+		{
+			name:        "null prior stays null",
+			prior:       types.StringNull(),
+			description: "",
+			want:        types.StringNull(),
+		},
+		{
+			name:        "empty prior stays empty",
+			prior:       types.StringValue(""),
+			description: "",
+			want:        types.StringValue(""),
+		},
+		{
+			name:        "cleared outside Terraform",
+			prior:       types.StringValue("Old description"),
+			description: "",
+			want:        types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := DatabasePolicyModel{Description: tt.prior}
+			if err := m.FromSDK(context.Background(), newTestPolicy(tt.description)); err != nil {
+				t.Fatalf("FromSDK() error = %v", err)
+			}
+			if !m.Description.Equal(tt.want) {
+				t.Errorf("FromSDK() description = %v, want %v", m.Description, tt.want)
+			}
+		})
+	}
+}
+
+// TestDatabasePolicyModel_FromSDK_DelegationClassification tests case normalization of delegation_classification
+func TestDatabasePolicyModel_FromSDK_DelegationClassification(t *testing.T) {
+	tests := []struct {
+		name  string
+		prior types.String
+		api   string
+		want  types.String
+	}{
+		{
+			name:  "null prior (import) lowercases API value",
+			prior: types.StringNull(),
+			api:   "Unrestricted",
+			want:  types.StringValue("unrestricted"),
+		},
+		{
+			name:  "lowercase prior kept",
+			prior: types.StringValue("unrestricted"),
+			api:   "Unrestricted",
+			want:  types.StringValue("unrestricted"),
+		},
+		{
+			name:  "titlecase prior kept",
+			prior: types.StringValue("Unrestricted"),
+			api:   "Unrestricted",
+			want:  types.StringValue("Unrestricted"),
+		},
+		{
+			name:  "changed outside Terraform",
+			prior: types.StringValue("Unrestricted"),
+			api:   "Restricted",
+			want:  types.StringValue("restricted"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestPolicy("")
+			policy.DelegationClassification = tt.api
+
+			m := DatabasePolicyModel{DelegationClassification: tt.prior}
+			if err := m.FromSDK(context.Background(), policy); err != nil {
+				t.Fatalf("FromSDK() error = %v", err)
+			}
+			if !m.DelegationClassification.Equal(tt.want) {
+				t.Errorf("FromSDK() delegation_classification = %v, want %v", m.DelegationClassification, tt.want)
+			}
+		})
+	}
+}
+
+// TestDatabasePolicyModel_FromSDK_PrincipalOrder tests that principals keep the prior block
+// order and spelling, with new principals appended and removed principals dropped
+func TestDatabasePolicyModel_FromSDK_PrincipalOrder(t *testing.T) {
+	principal := func(id, name string) InlinePrincipalModel {
+		return InlinePrincipalModel{
+			PrincipalID:         types.StringValue(id),
+			PrincipalType:       types.StringValue("USER"),
+			PrincipalName:       types.StringValue(name),
+			SourceDirectoryName: types.StringValue("CyberArk Cloud Directory"),
+			SourceDirectoryID:   types.StringValue("dir-1"),
+		}
+	}
+	apiPrincipal := func(id, name string) uapcommonmodels.ArkUAPPrincipal {
+		return uapcommonmodels.ArkUAPPrincipal{
+			ID:                  id,
+			Type:                "USER",
+			Name:                name,
+			SourceDirectoryName: "CyberArk Cloud Directory",
+			SourceDirectoryID:   "dir-1",
+		}
+	}
+
+	tests := []struct {
+		name  string
+		prior []InlinePrincipalModel
+		api   []uapcommonmodels.ArkUAPPrincipal
+		want  []InlinePrincipalModel
+	}{
+		{
+			name:  "no prior keeps API order",
+			prior: nil,
+			api:   []uapcommonmodels.ArkUAPPrincipal{apiPrincipal("b", "bob@example.com"), apiPrincipal("a", "alice@example.com")},
+			want:  []InlinePrincipalModel{principal("b", "bob@example.com"), principal("a", "alice@example.com")},
+		},
+		{
+			name:  "prior order and spelling kept",
+			prior: []InlinePrincipalModel{principal("a", "Alice@Example.com"), principal("b", "bob@example.com")},
+			api:   []uapcommonmodels.ArkUAPPrincipal{apiPrincipal("b", "bob@example.com"), apiPrincipal("A", "alice@example.com")},
+			want:  []InlinePrincipalModel{principal("a", "Alice@Example.com"), principal("b", "bob@example.com")},
+		},
+		{
+			name:  "renamed outside Terraform",
+			prior: []InlinePrincipalModel{principal("a", "alice@example.com")},
+			api:   []uapcommonmodels.ArkUAPPrincipal{apiPrincipal("a", "alice.smith@example.com")},
+			want:  []InlinePrincipalModel{principal("a", "alice.smith@example.com")},
+		},
+		{
+			name:  "new principals appended and removed principals dropped",
+			prior: []InlinePrincipalModel{principal("a", "alice@example.com"), principal("b", "bob@example.com")},
+			api:   []uapcommonmodels.ArkUAPPrincipal{apiPrincipal("c", "carol@example.com"), apiPrincipal("b", "bob@example.com")},
+			want:  []InlinePrincipalModel{principal("b", "bob@example.com"), principal("c", "carol@example.com")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestPolicy("")
+			policy.Principals = tt.api
+
+			m := DatabasePolicyModel{Principal: tt.prior}
+			if err := m.FromSDK(context.Background(), policy); err != nil {
+				t.Fatalf("FromSDK() error = %v", err)
+			}
+			if len(m.Principal) != len(tt.want) {
+				t.Fatalf("FromSDK() principals = %+v, want %+v", m.Principal, tt.want)
+			}
+			for i := range tt.want {
+				if m.Principal[i] != tt.want[i] {
+					t.Errorf("FromSDK() principal[%d] = %+v, want %+v", i, m.Principal[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestLastModifiedFromSDK tests the update timestamp takes precedence over creation
+func TestLastModifiedFromSDK(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata uapcommonmodels.ArkUAPMetadata
+		want     types.String
+	}{
+		{
+			name:     "no timestamps",
+			metadata: uapcommonmodels.ArkUAPMetadata{},
+			want:     types.StringNull(),
+		},
+		{
+			name: "never updated",
+			metadata: uapcommonmodels.ArkUAPMetadata{
+				CreatedBy: uapcommonmodels.ArkUAPChangeInfo{User: "creator", Time: "2026-01-01T00:00:00Z"},
+			},
+			want: types.StringValue("2026-01-01T00:00:00Z"),
+		},
+		{
+			name: "updated",
+			metadata: uapcommonmodels.ArkUAPMetadata{
+				CreatedBy: uapcommonmodels.ArkUAPChangeInfo{User: "creator", Time: "2026-01-01T00:00:00Z"},
+				UpdatedOn: uapcommonmodels.ArkUAPChangeInfo{User: "editor", Time: "2026-02-01T00:00:00Z"},
+			},
+			want: types.StringValue("2026-02-01T00:00:00Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LastModifiedFromSDK(tt.metadata); !got.Equal(tt.want) {
+				t.Errorf("LastModifiedFromSDK() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
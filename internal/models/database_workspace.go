@@ -2,6 +2,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,4 +28,6 @@ type DatabaseWorkspaceModel struct {
 	CloudProvider               types.String `tfsdk:"cloud_provider"`
 	Port                        types.Int64  `tfsdk:"port"`
 	EnableCertificateValidation types.Bool   `tfsdk:"enable_certificate_validation"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/exp/slices"
 )
@@ -30,6 +31,8 @@ type PolicyPrincipalAssignmentModel struct {
 
 	// Computed
 	LastModified types.String `tfsdk:"last_modified"` // Timestamp of last update
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ToSDKPrincipal converts Terraform state model to ARK SDK principal struct
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CertificateDataSource{}
+
+func NewCertificateDataSource() datasource.DataSource {
+	return &CertificateDataSource{}
+}
+
+// CertificateDataSource defines the data source implementation.
+type CertificateDataSource struct {
+	certificatesAPI *client.CertificatesClient
+}
+
+// CertificateDataSourceModel describes the data source data model.
+type CertificateDataSourceModel struct {
+	// Input
+	CertName types.String `tfsdk:"cert_name"`
+
+	// Computed
+	ID              types.String `tfsdk:"id"`
+	CertificateID   types.String `tfsdk:"certificate_id"`
+	CertBody        types.String `tfsdk:"cert_body"`
+	CertDescription types.String `tfsdk:"cert_description"`
+	CertType        types.String `tfsdk:"cert_type"`
+	DomainName      types.String `tfsdk:"domain_name"`
+	Labels          types.Map    `tfsdk:"labels"`
+	ExpirationDate  types.String `tfsdk:"expiration_date"`
+	Metadata        types.Object `tfsdk:"metadata"`
+}
+
+// Metadata returns the data source type name.
+func (d *CertificateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+// Schema defines the schema for the data source.
+func (d *CertificateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing SIA certificate by name. Use this data source to reference certificates " +
+			"uploaded outside Terraform, e.g. as `certificate_id` in `cyberarksia_database_workspace`.",
+
+		Attributes: map[string]schema.Attribute{
+			"cert_name": schema.StringAttribute{
+				MarkdownDescription: "The exact name of the certificate. Must match exactly one certificate.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The certificate ID (same as `certificate_id`).",
+				Computed:            true,
+			},
+			"certificate_id": schema.StringAttribute{
+				MarkdownDescription: "Unique certificate identifier assigned by SIA.",
+				Computed:            true,
+			},
+			"cert_body": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate content.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"cert_description": schema.StringAttribute{
+				MarkdownDescription: "Human-readable description of the certificate's purpose.",
+				Computed:            true,
+			},
+			"cert_type": schema.StringAttribute{
+				MarkdownDescription: "Certificate format (`PEM`). The list API does not return it, so it is derived from the body.",
+				Computed:            true,
+			},
+			"domain_name": schema.StringAttribute{
+				MarkdownDescription: "Logical domain to which the certificate is assigned.",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Key-value pairs for categorization and filtering.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"expiration_date": schema.StringAttribute{
+				MarkdownDescription: "Certificate expiration date in ISO 8601 format.",
+				Computed:            true,
+			},
+			"metadata": schema.SingleNestedAttribute{
+				MarkdownDescription: "Certificate metadata extracted from the X.509 structure by SIA.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						MarkdownDescription: "Certificate issuer Distinguished Name (DN).",
+						Computed:            true,
+					},
+					"subject": schema.StringAttribute{
+						MarkdownDescription: "Certificate subject Distinguished Name (DN).",
+						Computed:            true,
+					},
+					"valid_from": schema.StringAttribute{
+						MarkdownDescription: "Certificate validity start time (Unix timestamp as string).",
+						Computed:            true,
+					},
+					"valid_to": schema.StringAttribute{
+						MarkdownDescription: "Certificate validity end time (Unix timestamp as string).",
+						Computed:            true,
+					},
+					"serial_number": schema.StringAttribute{
+						MarkdownDescription: "Certificate serial number in decimal format.",
+						Computed:            true,
+					},
+					"subject_alternative_name": schema.ListAttribute{
+						MarkdownDescription: "Subject Alternative Names (SANs). Empty if none present.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure creates the certificates client from provider data.
+func (d *CertificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	certsClient, err := client.NewCertificatesClient(ctx, providerData.AuthContext)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Initialize Certificates Client",
+			fmt.Sprintf("Unable to create certificates client: %s", err.Error()),
+		)
+		return
+	}
+	certsClient.SetRetryConfig(providerData.retryConfig())
+
+	d.certificatesAPI = certsClient
+}
+
+// Read lists certificates and resolves the single certificate with the given name.
+func (d *CertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.certificatesAPI == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured ProviderData. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data CertificateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certName := data.CertName.ValueString()
+
+	certs, err := d.certificatesAPI.ListCertificates(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(client.MapCertificateError(err, "list certificates"))
+		return
+	}
+
+	matches := findCertificatesByName(certs, certName)
+
+	tflog.Debug(ctx, "Certificate lookup complete", map[string]interface{}{
+		"cert_name": certName,
+		"listed":    len(certs),
+		"matches":   len(matches),
+	})
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Certificate Not Found",
+			fmt.Sprintf("No certificate found with name '%s'.", certName),
+		)
+		return
+	}
+	if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.CertificateID
+		}
+		resp.Diagnostics.AddError(
+			"Multiple Certificates Found",
+			fmt.Sprintf("Found %d certificates named '%s' (IDs: %s). Certificate names must be unique to be looked up.",
+				len(matches), certName, strings.Join(ids, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromListItem(ctx, &matches[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Successfully read certificate", map[string]interface{}{
+		logKeyCertificateID: data.CertificateID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findCertificatesByName returns the certificates whose name equals name exactly
+func findCertificatesByName(certs []client.CertificateListItem, name string) []client.CertificateListItem {
+	var matches []client.CertificateListItem
+	for _, cert := range certs {
+		if cert.CertName == name {
+			matches = append(matches, cert)
+		}
+	}
+	return matches
+}
+
+// fromListItem populates the computed attributes from a LIST item. The LIST
+// response names differ from GET: body is cert_body and domain is domain_name.
+func (m *CertificateDataSourceModel) fromListItem(ctx context.Context, cert *client.CertificateListItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(cert.CertificateID)
+	m.CertificateID = types.StringValue(cert.CertificateID)
+	m.CertName = types.StringValue(cert.CertName)
+	m.CertBody = types.StringValue(cert.Body)
+	m.DomainName = types.StringValue(cert.Domain)
+	m.CertDescription = types.StringValue(cert.CertDescription)
+	m.ExpirationDate = types.StringValue(cert.ExpirationDate)
+
+	// SIA only accepts PEM; LIST omits cert_type, so infer it from the body
+	if strings.Contains(cert.Body, "-----BEGIN CERTIFICATE-----") {
+		m.CertType = types.StringValue("PEM")
+	} else {
+		m.CertType = types.StringNull()
+	}
+
+	if len(cert.Labels) > 0 {
+		labels, labelDiags := types.MapValueFrom(ctx, types.StringType, cert.Labels)
+		diags.Append(labelDiags...)
+		m.Labels = labels
+	} else {
+		m.Labels = types.MapNull(types.StringType)
+	}
+
+	metadata, metaDiags := certificateMetadataObject(ctx, cert.Metadata)
+	diags.Append(metaDiags...)
+	m.Metadata = metadata
+
+	return diags
+}
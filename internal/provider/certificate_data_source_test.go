@@ -0,0 +1,154 @@
+// Package provider implements tests for certificate_data_source
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/ark-sdk-golang/pkg/common"
+	"github.com/cyberark/ark-sdk-golang/pkg/common/isp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+)
+
+// TestAccCertificateDataSource_byName tests looking up a certificate by name
+// Validates:
+// - certificate_id, cert_body and metadata match the managed certificate
+// - A name matching no certificate returns an error
+func TestAccCertificateDataSource_byName(t *testing.T) {
+	certPEM, cert := testAccCertificateFixture(t)
+	config := testAccCertificateConfigFixture("lookup", "test-ds-lookup-cert", certPEM) + `
+data "cyberarksia_certificate" "lookup" {
+  cert_name = cyberarksia_certificate.lookup.cert_name
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cyberarksia_certificate.lookup", "certificate_id",
+						"cyberarksia_certificate.lookup", "certificate_id"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_certificate.lookup", "expiration_date",
+						"cyberarksia_certificate.lookup", "expiration_date"),
+					resource.TestCheckResourceAttr("data.cyberarksia_certificate.lookup", "cert_type", "PEM"),
+					resource.TestCheckResourceAttrSet("data.cyberarksia_certificate.lookup", "cert_body"),
+					resource.TestCheckResourceAttr("data.cyberarksia_certificate.lookup", "metadata.valid_to",
+						strconv.FormatInt(cert.NotAfter.Unix(), 10)),
+				),
+			},
+			{
+				Config: config + `
+data "cyberarksia_certificate" "missing" {
+  cert_name = "test-ds-cert-does-not-exist"
+}
+`,
+				ExpectError: mustCompileRegex(`Certificate Not Found`),
+			},
+		},
+	})
+}
+
+// Test that Read reports duplicate names as an error diagnostic instead of picking one
+func TestCertificateDataSource_ReadDuplicateNames(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"certificates": {"items": [
+			{"certificate_id": "1111111111111111", "cert_name": "shared"},
+			{"certificate_id": "2222222222222222", "cert_name": "shared"}
+		]}}`)) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(common.ArkDisableCertificateVerificationEnvVar, "true")
+
+	d := &CertificateDataSource{
+		certificatesAPI: client.NewCertificatesClientWithISPClient(&isp.ArkISPServiceClient{
+			ArkClient: common.NewSimpleArkClient(strings.TrimPrefix(server.URL, "https://")),
+		}),
+	}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := config.SetAttribute(ctx, path.Root("cert_name"), "shared"); diags.HasError() {
+		t.Fatalf("SetAttribute(cert_name) diagnostics: %v", diags)
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Read() returned no error for duplicate certificate names")
+	}
+	summary := resp.Diagnostics.Errors()[0].Summary()
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	if summary != "Multiple Certificates Found" || !strings.Contains(detail, "1111111111111111, 2222222222222222") {
+		t.Errorf("Read() error = %q: %q, want Multiple Certificates Found listing both IDs", summary, detail)
+	}
+}
+
+func TestCertificateDataSourceModel_fromListItem(t *testing.T) {
+	ctx := context.Background()
+	item := &client.CertificateListItem{
+		CertificateID:   "1234567890123456",
+		CertName:        "internal-ca",
+		CertDescription: "Internal CA",
+		Body:            "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+		Domain:          "db.example.com",
+		ExpirationDate:  "2030-01-01T00:00:00Z",
+		Labels:          map[string]string{"env": "prod"},
+		Metadata: &client.CertificateMetadata{
+			Issuer:                 "CN=Internal CA",
+			SerialNumber:           "01",
+			SubjectAlternativeName: []string{"db.example.com"},
+		},
+	}
+
+	var m CertificateDataSourceModel
+	if diags := m.fromListItem(ctx, item); diags.HasError() {
+		t.Fatalf("fromListItem() diagnostics: %v", diags)
+	}
+
+	if m.CertBody.ValueString() != item.Body {
+		t.Errorf("CertBody = %q, want LIST body %q", m.CertBody.ValueString(), item.Body)
+	}
+	if m.DomainName.ValueString() != "db.example.com" {
+		t.Errorf("DomainName = %s, want LIST domain db.example.com", m.DomainName)
+	}
+	if m.CertType.ValueString() != "PEM" {
+		t.Errorf("CertType = %s, want PEM", m.CertType)
+	}
+	if m.ID.ValueString() != item.CertificateID || m.CertificateID.ValueString() != item.CertificateID {
+		t.Errorf("ID/CertificateID = %s/%s, want %s", m.ID, m.CertificateID, item.CertificateID)
+	}
+	if got := m.Metadata.Attributes()["issuer"].String(); got != `"CN=Internal CA"` {
+		t.Errorf("metadata.issuer = %s, want \"CN=Internal CA\"", got)
+	}
+	if len(m.Labels.Elements()) != 1 {
+		t.Errorf("Labels = %s, want 1 element", m.Labels)
+	}
+
+	// Missing metadata maps to a null object, not an error
+	item.Metadata = nil
+	if diags := m.fromListItem(ctx, item); diags.HasError() || !m.Metadata.IsNull() {
+		t.Errorf("fromListItem() without metadata = %s, %v; want null object", m.Metadata, diags)
+	}
+}
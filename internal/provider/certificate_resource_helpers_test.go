@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/ark-sdk-golang/pkg/common"
+	"github.com/cyberark/ark-sdk-golang/pkg/common/isp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+)
+
+// Test that Read drops the certificate from state when GetCertificate reports 404 as nil, nil
+func TestCertificateResource_ReadNotFoundRemovesResource(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{}`)) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(common.ArkDisableCertificateVerificationEnvVar, "true")
+
+	certsClient := client.NewCertificatesClientWithISPClient(&isp.ArkISPServiceClient{
+		ArkClient: common.NewSimpleArkClient(strings.TrimPrefix(server.URL, "https://")),
+	})
+
+	cert, err := certsClient.GetCertificate(ctx, "1761251731882561")
+	if err != nil || cert != nil {
+		t.Fatalf("GetCertificate() = %+v, %v; want nil, nil for 404", cert, err)
+	}
+
+	r := &CertificateResource{certificatesAPI: certsClient}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema() diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for attrName, value := range map[string]string{
+		"id":             "1761251731882561",
+		"certificate_id": "1761251731882561",
+	} {
+		if diags := state.SetAttribute(ctx, path.Root(attrName), value); diags.HasError() {
+			t.Fatalf("SetAttribute(%s) diagnostics: %v", attrName, diags)
+		}
+	}
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("Read() kept certificate in state, want it removed")
+	}
+}
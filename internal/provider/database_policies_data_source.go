@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/cyberark/ark-sdk-golang/pkg/services/uap"
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiadb "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatabasePoliciesDataSource{}
+
+func NewDatabasePoliciesDataSource() datasource.DataSource {
+	return &DatabasePoliciesDataSource{}
+}
+
+// DatabasePoliciesDataSource defines the data source implementation.
+type DatabasePoliciesDataSource struct {
+	providerData *ProviderData
+}
+
+// DatabasePoliciesDataSourceModel describes the data source data model.
+type DatabasePoliciesDataSourceModel struct {
+	// Optional filters
+	Status    types.String `tfsdk:"status"`
+	NameRegex types.String `tfsdk:"name_regex"`
+
+	// Computed
+	Policies []DatabasePoliciesItemModel `tfsdk:"policies"`
+}
+
+// DatabasePoliciesItemModel describes a single policy returned by the data source.
+type DatabasePoliciesItemModel struct {
+	PolicyID                 types.String `tfsdk:"policy_id"`
+	Name                     types.String `tfsdk:"name"`
+	Status                   types.String `tfsdk:"status"`
+	DelegationClassification types.String `tfsdk:"delegation_classification"`
+	TimeZone                 types.String `tfsdk:"time_zone"`
+	LastModified             types.String `tfsdk:"last_modified"`
+	Description              types.String `tfsdk:"description"`
+}
+
+// databasePoliciesFilter holds the configured filters
+type databasePoliciesFilter struct {
+	status    string         // case-insensitive, empty for any
+	nameRegex *regexp.Regexp // nil for any
+}
+
+// Metadata returns the data source type name.
+func (d *DatabasePoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_policies"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabasePoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists SIA database access policies, optionally filtered by status or a name regular expression. " +
+			"Use this data source to inventory policies for audit and reporting.\n\n" +
+			"Policies are returned sorted by name. A filter matching no policy returns an empty list rather than an error.",
+
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return policies with this status (e.g., `Active`, `Suspended`). Values are case-insensitive.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(policyStatuses...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return policies whose name matches this regular expression (Go RE2 syntax, unanchored).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"policies": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching policies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier (UUID) of the policy.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the policy.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The current status of the policy (e.g., `Active`, `Suspended`).",
+							Computed:            true,
+						},
+						"delegation_classification": schema.StringAttribute{
+							MarkdownDescription: "Delegation classification of the policy (`restricted` or `unrestricted`).",
+							Computed:            true,
+						},
+						"time_zone": schema.StringAttribute{
+							MarkdownDescription: "Timezone used for the policy's access window conditions.",
+							Computed:            true,
+						},
+						"last_modified": schema.StringAttribute{
+							MarkdownDescription: "Timestamp of the last modification to the policy (the update timestamp, or the creation timestamp if never updated).",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the policy.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source with provider data.
+func (d *DatabasePoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+// Read lists policies, applies the filters, and fetches full details for each match.
+func (d *DatabasePoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.providerData == nil || d.providerData.UAPClient == nil {
+		resp.Diagnostics.AddError(
+			"UAP Client Not Configured",
+			"The UAP client is not available. This is a provider configuration issue.",
+		)
+		return
+	}
+
+	var data DatabasePoliciesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := databasePoliciesFilter{status: data.Status.ValueString()}
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("name_regex is not a valid regular expression: %s", err.Error()),
+			)
+			return
+		}
+		filter.nameRegex = nameRegex
+	}
+
+	uapAPI := d.providerData.UAPClient
+	retryConfig := d.providerData.retryConfig()
+
+	policies, err := listAllPolicies(ctx, uapAPI)
+	if err != nil {
+		resp.Diagnostics.Append(client.MapError(err, "list access policies"))
+		return
+	}
+
+	matches := filterDatabasePolicies(policies, filter)
+
+	tflog.Debug(ctx, "Listed access policies", map[string]interface{}{
+		"status":      filter.status,
+		"name_regex":  data.NameRegex.ValueString(),
+		"match_count": len(matches),
+	})
+
+	// The list response is not guaranteed to carry every attribute, so each
+	// match is fetched by ID (as in cyberarksia_database_policy)
+	data.Policies = make([]DatabasePoliciesItemModel, 0, len(matches))
+	for _, match := range matches {
+		policyID := match.Metadata.PolicyID
+
+		var policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy
+		err := client.RetryWithBackoff(ctx, retryConfig, func() error {
+			var apiErr error
+			policy, apiErr = uapAPI.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{PolicyID: policyID})
+			return apiErr
+		})
+		if err != nil {
+			// Deleted between list and get - skip rather than fail the whole read
+			if client.IsNotFoundError(err) {
+				tflog.Warn(ctx, "Access policy disappeared while listing, skipping", map[string]interface{}{
+					logKeyPolicyID: policyID,
+				})
+				continue
+			}
+			resp.Diagnostics.Append(client.MapError(err, fmt.Sprintf("read access policy %s", policyID)))
+			return
+		}
+
+		data.Policies = append(data.Policies, databasePoliciesItemFromSDK(policy))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAllPolicies lists every access policy. Only the list response is returned; callers
+// needing targets or principals must read each policy by ID.
+// The SDK's ListPolicies never fails itself: it fetches pages in a goroutine and, when that
+// fetch fails, leaves the channel open without sending. The call is therefore not retried,
+// and the pages are read until ctx is done
+func listAllPolicies(ctx context.Context, uapAPI *uap.ArkUAPAPI) ([]*uapsiadbmodels.ArkUAPSIADBAccessPolicy, error) {
+	pages, err := uapAPI.Db().ListPolicies()
+	if err != nil {
+		return nil, err
+	}
+	return policiesFromPages(ctx, pages)
+}
+
+// policiesFromPages collects the policies from every page until the channel is closed,
+// skipping nil items and items without an ID. It returns ctx.Err() if ctx is done first
+func policiesFromPages(ctx context.Context, pages <-chan *uapsiadb.ArkUAPDBPolicyPage) ([]*uapsiadbmodels.ArkUAPSIADBAccessPolicy, error) {
+	var policies []*uapsiadbmodels.ArkUAPSIADBAccessPolicy
+	for {
+		select {
+		case page, ok := <-pages:
+			if !ok {
+				return policies, nil
+			}
+			for _, policy := range page.Items {
+				if policy != nil && policy.Metadata.PolicyID != "" {
+					policies = append(policies, policy)
+				}
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// filterDatabasePolicies returns the policies matching filter, sorted by name then ID
+func filterDatabasePolicies(policies []*uapsiadbmodels.ArkUAPSIADBAccessPolicy, filter databasePoliciesFilter) []*uapsiadbmodels.ArkUAPSIADBAccessPolicy {
+	matches := []*uapsiadbmodels.ArkUAPSIADBAccessPolicy{}
+	for _, policy := range policies {
+		if filter.status != "" && !strings.EqualFold(policy.Metadata.Status.Status, filter.status) {
+			continue
+		}
+		if filter.nameRegex != nil && !filter.nameRegex.MatchString(policy.Metadata.Name) {
+			continue
+		}
+		matches = append(matches, policy)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Metadata.Name != matches[j].Metadata.Name {
+			return matches[i].Metadata.Name < matches[j].Metadata.Name
+		}
+		return matches[i].Metadata.PolicyID < matches[j].Metadata.PolicyID
+	})
+	return matches
+}
+
+// databasePoliciesItemFromSDK maps a policy read by ID to the data source item model
+// Field mapping matches DatabasePolicyDataSourceModel.fromSDK
+func databasePoliciesItemFromSDK(policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy) DatabasePoliciesItemModel {
+	item := DatabasePoliciesItemModel{
+		PolicyID:                 types.StringValue(policy.Metadata.PolicyID),
+		Name:                     types.StringValue(policy.Metadata.Name),
+		Status:                   types.StringValue(policy.Metadata.Status.Status),
+		DelegationClassification: types.StringValue(strings.ToLower(policy.DelegationClassification)),
+		TimeZone:                 types.StringValue(policy.Metadata.TimeZone),
+		Description:              types.StringValue(policy.Metadata.Description),
+		LastModified:             models.LastModifiedFromSDK(policy.Metadata),
+	}
+
+	return item
+}
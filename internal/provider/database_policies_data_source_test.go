@@ -0,0 +1,268 @@
+// Package provider implements tests for database_policies_data_source
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiadb "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatabasePoliciesDataSource_filters tests listing policies with each filter
+// Validates:
+// - name_regex returns both policies created by this test, sorted by name
+// - status narrows the result to the suspended policy
+// - A filter matching no policy returns an empty list instead of an error
+func TestAccDatabasePoliciesDataSource_filters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabasePoliciesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// name_regex only
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policies.by_regex", "policies.#", "2"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_policies.by_regex", "policies.0.policy_id",
+						"cyberarksia_database_policy.list_active", "policy_id"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_policies.by_regex", "policies.1.policy_id",
+						"cyberarksia_database_policy.list_suspended", "policy_id"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policies.by_regex", "policies.0.status", "Active"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policies.by_regex", "policies.0.description", "Listed by tf-acc"),
+					resource.TestCheckResourceAttrSet("data.cyberarksia_database_policies.by_regex", "policies.0.last_modified"),
+
+					// name_regex + status
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policies.by_status", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policies.by_status", "policies.0.name", "tf-acc-list-policy-suspended"),
+
+					// No match
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policies.none", "policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatabasePoliciesDataSourceConfig = `
+resource "cyberarksia_secret" "list_policies" {
+  name                = "tf-acc-list-policies-secret"
+  authentication_type = "local"
+  username            = "db_user"
+  password            = "SecurePassword123!"
+}
+
+resource "cyberarksia_database_workspace" "list_policies" {
+  name                  = "tf-acc-list-policies-db"
+  database_type         = "postgres"
+  address               = "postgres-list-policies.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = cyberarksia_secret.list_policies.id
+}
+
+data "cyberarksia_principal" "list_policies_user" {
+  name = "tim.schindler@cyberark.cloud.40562"
+  type = "USER"
+}
+
+resource "cyberarksia_database_policy" "list_active" {
+  name        = "tf-acc-list-policy-active"
+  description = "Listed by tf-acc"
+  status      = "active"
+
+  conditions {
+    max_session_duration = 8
+  }
+
+  target_database {
+    database_workspace_id = cyberarksia_database_workspace.list_policies.id
+    authentication_method = "db_auth"
+
+    db_auth_profile {
+      roles = ["readonly"]
+    }
+  }
+
+  principal {
+    principal_id          = data.cyberarksia_principal.list_policies_user.id
+    principal_type        = data.cyberarksia_principal.list_policies_user.principal_type
+    principal_name        = data.cyberarksia_principal.list_policies_user.name
+    source_directory_name = data.cyberarksia_principal.list_policies_user.directory_name
+    source_directory_id   = data.cyberarksia_principal.list_policies_user.directory_id
+  }
+}
+
+resource "cyberarksia_database_policy" "list_suspended" {
+  name   = "tf-acc-list-policy-suspended"
+  status = "suspended"
+
+  conditions {
+    max_session_duration = 8
+  }
+
+  target_database {
+    database_workspace_id = cyberarksia_database_workspace.list_policies.id
+    authentication_method = "db_auth"
+
+    db_auth_profile {
+      roles = ["readonly"]
+    }
+  }
+
+  principal {
+    principal_id          = data.cyberarksia_principal.list_policies_user.id
+    principal_type        = data.cyberarksia_principal.list_policies_user.principal_type
+    principal_name        = data.cyberarksia_principal.list_policies_user.name
+    source_directory_name = data.cyberarksia_principal.list_policies_user.directory_name
+    source_directory_id   = data.cyberarksia_principal.list_policies_user.directory_id
+  }
+}
+
+data "cyberarksia_database_policies" "by_regex" {
+  name_regex = "^tf-acc-list-policy-"
+
+  depends_on = [cyberarksia_database_policy.list_active, cyberarksia_database_policy.list_suspended]
+}
+
+data "cyberarksia_database_policies" "by_status" {
+  name_regex = "^tf-acc-list-policy-"
+  status     = "suspended"
+
+  depends_on = [cyberarksia_database_policy.list_active, cyberarksia_database_policy.list_suspended]
+}
+
+data "cyberarksia_database_policies" "none" {
+  name_regex = "^tf-acc-list-policy-does-not-exist$"
+
+  depends_on = [cyberarksia_database_policy.list_active, cyberarksia_database_policy.list_suspended]
+}
+`
+
+// Test that policies are collected from every page and nil or ID-less items are skipped
+func TestPoliciesFromPages(t *testing.T) {
+	pages := make(chan *uapsiadb.ArkUAPDBPolicyPage, 3)
+	pages <- &uapsiadb.ArkUAPDBPolicyPage{Items: []*uapsiadbmodels.ArkUAPSIADBAccessPolicy{
+		testPolicyWithName("id-1", "prod"),
+		nil,
+	}}
+	pages <- &uapsiadb.ArkUAPDBPolicyPage{Items: []*uapsiadbmodels.ArkUAPSIADBAccessPolicy{
+		testPolicyWithName("", "missing-id"),
+	}}
+	pages <- &uapsiadb.ArkUAPDBPolicyPage{Items: []*uapsiadbmodels.ArkUAPSIADBAccessPolicy{
+		testPolicyWithName("id-2", "dev"),
+	}}
+	close(pages)
+
+	policies, err := policiesFromPages(context.Background(), pages)
+	if err != nil {
+		t.Fatalf("policiesFromPages() error = %v", err)
+	}
+
+	gotIDs := []string{}
+	for _, policy := range policies {
+		gotIDs = append(gotIDs, policy.Metadata.PolicyID)
+	}
+	if diff := cmp.Diff([]string{"id-1", "id-2"}, gotIDs); diff != "" {
+		t.Errorf("policiesFromPages() IDs mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := <-pages; ok {
+		t.Error("policiesFromPages() left pages unread")
+	}
+}
+
+// Test that reading pages stops when ctx is done, since the SDK leaves the channel
+// open when the list request fails
+func TestPoliciesFromPages_contextDone(t *testing.T) {
+	pages := make(chan *uapsiadb.ArkUAPDBPolicyPage, 1)
+	pages <- &uapsiadb.ArkUAPDBPolicyPage{Items: []*uapsiadbmodels.ArkUAPSIADBAccessPolicy{
+		testPolicyWithName("id-1", "prod"),
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	policies, err := policiesFromPages(ctx, pages)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("policiesFromPages() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if policies != nil {
+		t.Errorf("policiesFromPages() = %v, want nil", policies)
+	}
+}
+
+func TestFilterDatabasePolicies(t *testing.T) {
+	newPolicy := func(id, name, status string) *uapsiadbmodels.ArkUAPSIADBAccessPolicy {
+		policy := testPolicyWithName(id, name)
+		policy.Metadata.Status = uapcommonmodels.ArkUAPPolicyStatus{Status: status}
+		return policy
+	}
+	policies := []*uapsiadbmodels.ArkUAPSIADBAccessPolicy{
+		newPolicy("id-3", "prod-read", "Active"),
+		newPolicy("id-1", "prod-admin", "Suspended"),
+		newPolicy("id-2", "dev-read", "Active"),
+		newPolicy("id-4", "prod-read", "Active"),
+	}
+
+	tests := []struct {
+		name    string
+		filter  databasePoliciesFilter
+		wantIDs []string
+	}{
+		{name: "no filter sorts by name then id", filter: databasePoliciesFilter{}, wantIDs: []string{"id-2", "id-1", "id-3", "id-4"}},
+		{name: "status is case-insensitive", filter: databasePoliciesFilter{status: "active"}, wantIDs: []string{"id-2", "id-3", "id-4"}},
+		{name: "name regex", filter: databasePoliciesFilter{nameRegex: regexp.MustCompile(`^prod-`)}, wantIDs: []string{"id-1", "id-3", "id-4"}},
+		{name: "combined", filter: databasePoliciesFilter{status: "Active", nameRegex: regexp.MustCompile(`read$`)}, wantIDs: []string{"id-2", "id-3", "id-4"}},
+		{name: "no match is empty", filter: databasePoliciesFilter{status: "Expired"}, wantIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIDs := []string{}
+			for _, policy := range filterDatabasePolicies(policies, tt.filter) {
+				gotIDs = append(gotIDs, policy.Metadata.PolicyID)
+			}
+			if diff := cmp.Diff(tt.wantIDs, gotIDs); diff != "" {
+				t.Errorf("filterDatabasePolicies() IDs mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatabasePoliciesItemFromSDK(t *testing.T) {
+	policy := testPolicyWithName("11111111-2222-3333-4444-555555555555", "prod-read")
+	policy.Metadata.Status = uapcommonmodels.ArkUAPPolicyStatus{Status: "Active"}
+	policy.Metadata.Description = "Production read access"
+	policy.Metadata.TimeZone = "GMT"
+	policy.Metadata.CreatedBy.Time = "2025-01-01T00:00:00Z"
+	policy.DelegationClassification = "Unrestricted"
+
+	item := databasePoliciesItemFromSDK(policy)
+
+	want := DatabasePoliciesItemModel{
+		PolicyID:                 types.StringValue("11111111-2222-3333-4444-555555555555"),
+		Name:                     types.StringValue("prod-read"),
+		Status:                   types.StringValue("Active"),
+		DelegationClassification: types.StringValue("unrestricted"),
+		TimeZone:                 types.StringValue("GMT"),
+		LastModified:             types.StringValue("2025-01-01T00:00:00Z"),
+		Description:              types.StringValue("Production read access"),
+	}
+	if item != want {
+		t.Errorf("databasePoliciesItemFromSDK() = %+v, want %+v", item, want)
+	}
+
+	// The update timestamp takes precedence over creation
+	policy.Metadata.UpdatedOn.Time = "2025-02-01T00:00:00Z"
+	if got := databasePoliciesItemFromSDK(policy).LastModified; got.ValueString() != "2025-02-01T00:00:00Z" {
+		t.Errorf("LastModified = %s, want the update timestamp", got)
+	}
+}
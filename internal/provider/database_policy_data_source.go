@@ -3,19 +3,29 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
 	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &DatabasePolicyDataSource{}
 
+// policyStatuses are the statuses the UAP API reports for a policy
+var policyStatuses = []string{"Active", "Suspended", "Expired", "Validating", "Error", "Warning"}
+
 func NewDatabasePolicyDataSource() datasource.DataSource {
 	return &DatabasePolicyDataSource{}
 }
@@ -27,14 +37,20 @@ type DatabasePolicyDataSource struct {
 
 // DatabasePolicyDataSourceModel describes the data source data model.
 type DatabasePolicyDataSourceModel struct {
-	// Input (one required)
+	// Input (one of policy_id or name required)
 	PolicyID types.String `tfsdk:"policy_id"`
 	Name     types.String `tfsdk:"name"`
+	Status   types.String `tfsdk:"status"`
 
 	// Computed
-	ID          types.String `tfsdk:"id"`
-	Description types.String `tfsdk:"description"`
-	Status      types.String `tfsdk:"status"`
+	ID                       types.String `tfsdk:"id"`
+	Description              types.String `tfsdk:"description"`
+	DelegationClassification types.String `tfsdk:"delegation_classification"`
+	TimeZone                 types.String `tfsdk:"time_zone"`
+	PolicyTags               types.List   `tfsdk:"policy_tags"`
+	CreatedBy                types.Object `tfsdk:"created_by"`
+	UpdatedOn                types.Object `tfsdk:"updated_on"`
+	LastModified             types.String `tfsdk:"last_modified"`
 }
 
 func (d *DatabasePolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,7 +79,58 @@ func (d *DatabasePolicyDataSource) Schema(ctx context.Context, req datasource.Sc
 				Computed:            true,
 			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "The current status of the policy (e.g., 'Active', 'Inactive').",
+				MarkdownDescription: "The current status of the policy (e.g., `Active`, `Suspended`). When set together with `name`, " +
+					"only policies with this status match. Values are case-insensitive and the configured spelling is kept in state.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(policyStatuses...),
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+			},
+			"delegation_classification": schema.StringAttribute{
+				MarkdownDescription: "Delegation classification of the policy (`restricted` or `unrestricted`).",
+				Computed:            true,
+			},
+			"time_zone": schema.StringAttribute{
+				MarkdownDescription: "Timezone used for the policy's access window conditions.",
+				Computed:            true,
+			},
+			"policy_tags": schema.ListAttribute{
+				MarkdownDescription: "Tags attached to the policy.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"created_by": schema.SingleNestedAttribute{
+				MarkdownDescription: "Metadata about policy creation.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"user": schema.StringAttribute{
+						MarkdownDescription: "Username of the user who created the policy.",
+						Computed:            true,
+					},
+					"timestamp": schema.StringAttribute{
+						MarkdownDescription: "Creation timestamp in ISO 8601 format.",
+						Computed:            true,
+					},
+				},
+			},
+			"updated_on": schema.SingleNestedAttribute{
+				MarkdownDescription: "Metadata about the last policy update.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"user": schema.StringAttribute{
+						MarkdownDescription: "Username of the user who last updated the policy.",
+						Computed:            true,
+					},
+					"timestamp": schema.StringAttribute{
+						MarkdownDescription: "Last update timestamp in ISO 8601 format.",
+						Computed:            true,
+					},
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the last modification to the policy (the `updated_on` timestamp, or the creation timestamp if never updated).",
 				Computed:            true,
 			},
 		},
@@ -115,48 +182,19 @@ func (d *DatabasePolicyDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	uapAPI := d.providerData.UAPClient
-	var policyID string
-
-	// Lookup by policy_id
-	if !data.PolicyID.IsNull() {
-		policyID = data.PolicyID.ValueString()
-		tflog.Debug(ctx, "Looking up policy by ID", map[string]interface{}{
-			"policy_id": policyID,
-		})
+	policyID := data.PolicyID.ValueString()
 
-		policy, err := uapAPI.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
-			PolicyID: policyID,
-		})
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Reading Policy",
-				fmt.Sprintf("Could not read policy with ID %s: %s", policyID, err.Error()),
-			)
-			return
-		}
-
-		// Populate computed fields
-		data.ID = types.StringValue(policy.Metadata.PolicyID)
-		data.Description = types.StringValue(policy.Metadata.Description)
-		data.Status = types.StringValue(policy.Metadata.Status.Status)
-
-		tflog.Info(ctx, "Successfully read policy by ID", map[string]interface{}{
-			"policy_id": policyID,
-			"name":      policy.Metadata.Name,
-		})
-	} else {
-		// Lookup by name
+	// Lookup by name resolves the policy ID first; the list response is not
+	// guaranteed to carry every attribute, so the policy is always fetched by ID
+	if data.PolicyID.IsNull() {
 		policyName := data.Name.ValueString()
+		policyStatus := data.Status.ValueString()
 		tflog.Debug(ctx, "Looking up policy by name", map[string]interface{}{
-			"name": policyName,
+			"name":   policyName,
+			"status": policyStatus,
 		})
 
-		// List all policies and filter by name
-		tflog.Debug(ctx, "Calling ListPolicies()", map[string]interface{}{
-			"searching_for": policyName,
-		})
-
-		policyPages, err := uapAPI.Db().ListPolicies()
+		policies, err := listAllPolicies(ctx, uapAPI)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Listing Policies",
@@ -165,60 +203,112 @@ func (d *DatabasePolicyDataSource) Read(ctx context.Context, req datasource.Read
 			return
 		}
 
-		tflog.Debug(ctx, "ListPolicies() returned channel, reading pages...")
-
-		var foundPolicy *uapsiadbmodels.ArkUAPSIADBAccessPolicy
-		var allPolicyNames []string
-		pageCount := 0
-		for page := range policyPages {
-			pageCount++
-			tflog.Debug(ctx, "Processing policy page", map[string]interface{}{
-				"page_number": pageCount,
-				"items_count": len(page.Items),
-			})
-			for _, policy := range page.Items {
-				allPolicyNames = append(allPolicyNames, policy.Metadata.Name)
-				tflog.Debug(ctx, "Found policy in list", map[string]interface{}{
-					"policy_name": policy.Metadata.Name,
-					"policy_id":   policy.Metadata.PolicyID,
-				})
-				if policy.Metadata.Name == policyName {
-					foundPolicy = policy
-					break
-				}
-			}
-			if foundPolicy != nil {
-				break
-			}
-		}
+		matches := findPoliciesByName(policies, policyName, policyStatus)
 
 		tflog.Debug(ctx, "Policy lookup complete", map[string]interface{}{
-			"searched_for":     policyName,
-			"pages_processed":  pageCount,
-			"policies_found":   len(allPolicyNames),
-			"all_policy_names": allPolicyNames,
+			"searched_for":    policyName,
+			"policies_listed": len(policies),
+			"matches":         len(matches),
 		})
 
-		if foundPolicy == nil {
+		switch len(matches) {
+		case 0:
+			detail := fmt.Sprintf("No policy found with name '%s'", policyName)
+			if policyStatus != "" {
+				detail += fmt.Sprintf(" and status '%s'", policyStatus)
+			}
 			resp.Diagnostics.AddError(
 				"Policy Not Found",
-				fmt.Sprintf("No policy found with name '%s'. Ensure the policy exists and you have permission to read it.", policyName),
+				detail+". Ensure the policy exists and you have permission to read it.",
+			)
+			return
+		case 1:
+			policyID = matches[0].Metadata.PolicyID
+		default:
+			ids := make([]string, len(matches))
+			for i, match := range matches {
+				ids[i] = match.Metadata.PolicyID
+			}
+			resp.Diagnostics.AddError(
+				"Multiple Policies Found",
+				fmt.Sprintf("Found %d policies named '%s' (IDs: %s). Use policy_id to select one.", len(matches), policyName, strings.Join(ids, ", ")),
 			)
 			return
 		}
+	}
 
-		// Populate computed fields
-		data.ID = types.StringValue(foundPolicy.Metadata.PolicyID)
-		data.PolicyID = types.StringValue(foundPolicy.Metadata.PolicyID)
-		data.Description = types.StringValue(foundPolicy.Metadata.Description)
-		data.Status = types.StringValue(foundPolicy.Metadata.Status.Status)
+	tflog.Debug(ctx, "Looking up policy by ID", map[string]interface{}{
+		logKeyPolicyID: policyID,
+	})
 
-		tflog.Info(ctx, "Successfully read policy by name", map[string]interface{}{
-			"name":      policyName,
-			"policy_id": foundPolicy.Metadata.PolicyID,
-		})
+	policy, err := uapAPI.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+		PolicyID: policyID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Policy",
+			fmt.Sprintf("Could not read policy with ID %s: %s", policyID, err.Error()),
+		)
+		return
 	}
 
+	configuredStatus := data.Status
+	resp.Diagnostics.Append(data.fromSDK(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configuredStatus.IsNull() {
+		data.Status = configuredStatus
+	}
+
+	tflog.Info(ctx, "Successfully read policy", map[string]interface{}{
+		logKeyPolicyID: policyID,
+		"name":         policy.Metadata.Name,
+	})
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// findPoliciesByName returns every policy named name, optionally narrowed to a
+// status (case-insensitive, empty matches any)
+func findPoliciesByName(policies []*uapsiadbmodels.ArkUAPSIADBAccessPolicy, name, status string) []*uapsiadbmodels.ArkUAPSIADBAccessPolicy {
+	var matches []*uapsiadbmodels.ArkUAPSIADBAccessPolicy
+	for _, policy := range policies {
+		if policy.Metadata.Name != name {
+			continue
+		}
+		if status == "" || strings.EqualFold(policy.Metadata.Status.Status, status) {
+			matches = append(matches, policy)
+		}
+	}
+	return matches
+}
+
+// fromSDK populates the computed attributes from a policy read by ID
+func (m *DatabasePolicyDataSourceModel) fromSDK(ctx context.Context, policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(policy.Metadata.PolicyID)
+	m.PolicyID = types.StringValue(policy.Metadata.PolicyID)
+	m.Name = types.StringValue(policy.Metadata.Name)
+	m.Description = types.StringValue(policy.Metadata.Description)
+	m.Status = types.StringValue(policy.Metadata.Status.Status)
+	m.DelegationClassification = types.StringValue(strings.ToLower(policy.DelegationClassification))
+	m.TimeZone = types.StringValue(policy.Metadata.TimeZone)
+
+	if len(policy.Metadata.PolicyTags) > 0 {
+		tags, tagDiags := types.ListValueFrom(ctx, types.StringType, policy.Metadata.PolicyTags)
+		diags.Append(tagDiags...)
+		m.PolicyTags = tags
+	} else {
+		m.PolicyTags = types.ListNull(types.StringType)
+	}
+
+	m.CreatedBy = models.ChangeInfoObject(policy.Metadata.CreatedBy.User, policy.Metadata.CreatedBy.Time)
+	m.UpdatedOn = models.ChangeInfoObject(policy.Metadata.UpdatedOn.User, policy.Metadata.UpdatedOn.Time)
+
+	m.LastModified = models.LastModifiedFromSDK(policy.Metadata)
+
+	return diags
+}
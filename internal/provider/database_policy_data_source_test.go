@@ -0,0 +1,257 @@
+// Package provider implements tests for database_policy_data_source
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatabasePolicyDataSource_byName tests looking up a policy by name
+// Validates:
+// - Scalar and audit attributes match the managed policy
+// - policy_id feeds cyberarksia_database_policy_database_assignment without conversion
+// - A name matching no policy returns an error instead of empty results
+// - A status filter that excludes the policy returns the same error
+func TestAccDatabasePolicyDataSource_byName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabasePolicyDataSourceConfig("test-ds-lookup-policy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_policy.lookup", "policy_id",
+						"cyberarksia_database_policy.lookup", "policy_id"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_policy.lookup", "delegation_classification",
+						"cyberarksia_database_policy.lookup", "delegation_classification"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_policy.lookup", "time_zone",
+						"cyberarksia_database_policy.lookup", "time_zone"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_policy.lookup", "created_by.user",
+						"cyberarksia_database_policy.lookup", "created_by.user"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policy.lookup", "status", "Active"),
+					resource.TestCheckResourceAttrSet("data.cyberarksia_database_policy.lookup", "last_modified"),
+					resource.TestCheckResourceAttrPair("cyberarksia_database_policy_database_assignment.lookup", "policy_id",
+						"data.cyberarksia_database_policy.lookup", "policy_id"),
+				),
+			},
+			{
+				Config: testAccDatabasePolicyDataSourceConfig("test-ds-lookup-policy") + `
+data "cyberarksia_database_policy" "missing" {
+  name = "test-ds-lookup-policy-does-not-exist"
+}
+`,
+				ExpectError: mustCompileRegex(`Policy Not Found`),
+			},
+			{
+				Config: testAccDatabasePolicyDataSourceConfig("test-ds-lookup-policy") + `
+data "cyberarksia_database_policy" "suspended" {
+  name   = cyberarksia_database_policy.lookup.name
+  status = "suspended"
+}
+`,
+				ExpectError: mustCompileRegex(`Policy Not Found`),
+			},
+		},
+	})
+}
+
+// testAccDatabasePolicyDataSourceConfig returns a policy looked up by name, with the
+// resolved policy_id used by a database assignment
+func testAccDatabasePolicyDataSourceConfig(policyName string) string {
+	return fmt.Sprintf(`
+resource "cyberarksia_secret" "lookup" {
+  name                = "test-ds-lookup-secret"
+  authentication_type = "local"
+  username            = "db_user"
+  password            = "TestPassword789!"
+}
+
+resource "cyberarksia_database_workspace" "lookup_anchor" {
+  name                  = "test-ds-lookup-anchor-db"
+  database_type         = "postgres"
+  address               = "postgres-ds-lookup-anchor.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = cyberarksia_secret.lookup.id
+}
+
+resource "cyberarksia_database_workspace" "lookup_assigned" {
+  name                  = "test-ds-lookup-assigned-db"
+  database_type         = "postgres"
+  address               = "postgres-ds-lookup-assigned.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = cyberarksia_secret.lookup.id
+}
+
+data "cyberarksia_principal" "lookup_user" {
+  name = "tim.schindler@cyberark.cloud.40562"
+  type = "USER"
+}
+
+resource "cyberarksia_database_policy" "lookup" {
+  name   = %q
+  status = "active"
+
+  conditions {
+    max_session_duration = 8
+  }
+
+  target_database {
+    database_workspace_id  = cyberarksia_database_workspace.lookup_anchor.id
+    authentication_method  = "db_auth"
+
+    db_auth_profile {
+      roles = ["readonly"]
+    }
+  }
+
+  principal {
+    principal_id          = data.cyberarksia_principal.lookup_user.id
+    principal_type        = data.cyberarksia_principal.lookup_user.principal_type
+    principal_name        = data.cyberarksia_principal.lookup_user.name
+    source_directory_name = data.cyberarksia_principal.lookup_user.directory_name
+    source_directory_id   = data.cyberarksia_principal.lookup_user.directory_id
+  }
+
+  lifecycle {
+    ignore_changes = [target_database]
+  }
+}
+
+data "cyberarksia_database_policy" "lookup" {
+  name = cyberarksia_database_policy.lookup.name
+}
+
+resource "cyberarksia_database_policy_database_assignment" "lookup" {
+  policy_id              = data.cyberarksia_database_policy.lookup.policy_id
+  database_workspace_id  = cyberarksia_database_workspace.lookup_assigned.id
+  authentication_method  = "db_auth"
+
+  db_auth_profile {
+    roles = ["connect"]
+  }
+}
+`, policyName)
+}
+
+func testPolicyWithName(id, name string) *uapsiadbmodels.ArkUAPSIADBAccessPolicy {
+	policy := &uapsiadbmodels.ArkUAPSIADBAccessPolicy{}
+	policy.Metadata.PolicyID = id
+	policy.Metadata.Name = name
+	return policy
+}
+
+func TestFindPoliciesByName(t *testing.T) {
+	policies := []*uapsiadbmodels.ArkUAPSIADBAccessPolicy{
+		testPolicyWithName("id-1", "prod"),
+		testPolicyWithName("id-2", "dev"),
+		testPolicyWithName("id-3", "prod"),
+	}
+
+	matches := findPoliciesByName(policies, "prod", "")
+
+	if len(matches) != 2 || matches[0].Metadata.PolicyID != "id-1" || matches[1].Metadata.PolicyID != "id-3" {
+		t.Errorf("matches = %v, want id-1 and id-3", matches)
+	}
+}
+
+func TestFindPoliciesByName_status(t *testing.T) {
+	active := testPolicyWithName("id-1", "prod")
+	active.Metadata.Status = uapcommonmodels.ArkUAPPolicyStatus{Status: "Active"}
+	suspended := testPolicyWithName("id-2", "prod")
+	suspended.Metadata.Status = uapcommonmodels.ArkUAPPolicyStatus{Status: "Suspended"}
+
+	tests := []struct {
+		status string
+		want   []string
+	}{
+		{status: "", want: []string{"id-1", "id-2"}},
+		{status: "Active", want: []string{"id-1"}},
+		{status: "suspended", want: []string{"id-2"}},
+		{status: "Expired", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			matches := findPoliciesByName([]*uapsiadbmodels.ArkUAPSIADBAccessPolicy{active, suspended}, "prod", tt.status)
+
+			var got []string
+			for _, match := range matches {
+				got = append(got, match.Metadata.PolicyID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("findPoliciesByName(status=%q) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatabasePolicyDataSourceModel_fromSDK(t *testing.T) {
+	policy := testPolicyWithName("policy-123", "prod")
+	policy.DelegationClassification = "Unrestricted"
+	policy.Metadata.Description = "Production access"
+	policy.Metadata.Status = uapcommonmodels.ArkUAPPolicyStatus{Status: "Active"}
+	policy.Metadata.TimeZone = "Europe/Amsterdam"
+	policy.Metadata.CreatedBy = uapcommonmodels.ArkUAPChangeInfo{User: "creator", Time: "2026-01-01T00:00:00Z"}
+
+	tests := []struct {
+		name             string
+		updatedOn        uapcommonmodels.ArkUAPChangeInfo
+		wantLastModified types.String
+		wantUpdatedNull  bool
+	}{
+		{
+			name:             "never updated falls back to creation time",
+			wantLastModified: types.StringValue("2026-01-01T00:00:00Z"),
+			wantUpdatedNull:  true,
+		},
+		{
+			name:             "updated uses update time",
+			updatedOn:        uapcommonmodels.ArkUAPChangeInfo{User: "editor", Time: "2026-02-01T00:00:00Z"},
+			wantLastModified: types.StringValue("2026-02-01T00:00:00Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy.Metadata.UpdatedOn = tt.updatedOn
+
+			var m DatabasePolicyDataSourceModel
+			if diags := m.fromSDK(context.Background(), policy); diags.HasError() {
+				t.Fatalf("fromSDK() diagnostics: %v", diags)
+			}
+
+			if m.PolicyID.ValueString() != "policy-123" || m.ID.ValueString() != "policy-123" {
+				t.Errorf("PolicyID/ID = %s/%s, want policy-123", m.PolicyID, m.ID)
+			}
+			if m.DelegationClassification.ValueString() != "unrestricted" {
+				t.Errorf("DelegationClassification = %s, want unrestricted", m.DelegationClassification)
+			}
+			if m.TimeZone.ValueString() != "Europe/Amsterdam" {
+				t.Errorf("TimeZone = %s, want Europe/Amsterdam", m.TimeZone)
+			}
+			if !m.PolicyTags.IsNull() {
+				t.Errorf("PolicyTags = %s, want null", m.PolicyTags)
+			}
+			if m.CreatedBy.IsNull() {
+				t.Error("CreatedBy is null, want creator")
+			}
+			if m.UpdatedOn.IsNull() != tt.wantUpdatedNull {
+				t.Errorf("UpdatedOn.IsNull() = %t, want %t", m.UpdatedOn.IsNull(), tt.wantUpdatedNull)
+			}
+			if !m.LastModified.Equal(tt.wantLastModified) {
+				t.Errorf("LastModified = %s, want %s", m.LastModified, tt.wantLastModified)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DatabasePolicyDatabaseAssignmentResource{}
 var _ resource.ResourceWithImportState = &DatabasePolicyDatabaseAssignmentResource{}
+var _ resource.ResourceWithValidateConfig = &DatabasePolicyDatabaseAssignmentResource{}
 
 func NewDatabasePolicyDatabaseAssignmentResource() resource.Resource {
 	return &DatabasePolicyDatabaseAssignmentResource{}
@@ -36,6 +38,14 @@ func NewDatabasePolicyDatabaseAssignmentResource() resource.Resource {
 // DatabasePolicyDatabaseAssignmentResource defines the resource implementation.
 type DatabasePolicyDatabaseAssignmentResource struct {
 	providerData *ProviderData
+	policyAPI    databasePolicyAPI
+}
+
+// databasePolicyAPI is the part of the UAP DB policy service this resource uses,
+// satisfied by UAPClient.Db() and by stubs in tests
+type databasePolicyAPI interface {
+	Policy(*uapcommonmodels.ArkUAPGetPolicyRequest) (*uapsiadbmodels.ArkUAPSIADBAccessPolicy, error)
+	UpdatePolicy(*uapsiadbmodels.ArkUAPSIADBAccessPolicy) (*uapsiadbmodels.ArkUAPSIADBAccessPolicy, error)
 }
 
 func (r *DatabasePolicyDatabaseAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,14 +58,14 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Schema(ctx context.Context, r
 			"This resource follows the AWS Security Group Rule pattern - manage individual database assignments " +
 			"to a policy rather than managing the entire policy.\n\n" +
 			"Policies can be created using the `cyberarksia_database_policy` resource or managed through the SIA UI. " +
-			"Use the `cyberarksia_access_policy` data source to reference existing policies.\n\n" +
+			"Use the `cyberarksia_database_policy` data source to reference existing policies.\n\n" +
 			"**IMPORTANT**: Multiple assignments to the same policy within a single Terraform workspace are supported. " +
 			"However, managing the same policy from multiple Terraform workspaces can cause conflicts. " +
 			"See the resource documentation for best practices.",
 
 		Attributes: map[string]schema.Attribute{
 			"policy_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the SIA access policy to add the database to. Use the `cyberarksia_access_policy` data source for lookup by name.",
+				MarkdownDescription: "The ID of the SIA access policy to add the database to. Use the `cyberarksia_database_policy` data source for lookup by name.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -133,7 +143,7 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Schema(ctx context.Context, r
 				},
 			},
 			"mongo_auth_profile": schema.SingleNestedBlock{
-				MarkdownDescription: "MongoDB authentication profile. Use when `authentication_method` is `mongo_auth`.",
+				MarkdownDescription: "MongoDB authentication profile. Use when `authentication_method` is `mongo_auth`. **Required** if authentication_method is `mongo_auth`.",
 				Attributes: map[string]schema.Attribute{
 					"global_builtin_roles": schema.ListAttribute{
 						MarkdownDescription: "List of global built-in roles to assign.",
@@ -153,7 +163,7 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Schema(ctx context.Context, r
 				},
 			},
 			"sqlserver_auth_profile": schema.SingleNestedBlock{
-				MarkdownDescription: "SQL Server authentication profile. Use when `authentication_method` is `sqlserver_auth`.",
+				MarkdownDescription: "SQL Server authentication profile. Use when `authentication_method` is `sqlserver_auth`. **Required** if authentication_method is `sqlserver_auth`.",
 				Attributes: map[string]schema.Attribute{
 					"global_builtin_roles": schema.ListAttribute{
 						MarkdownDescription: "List of global built-in roles to assign.",
@@ -186,6 +196,7 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Schema(ctx context.Context, r
 					},
 				},
 			},
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -205,6 +216,72 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Configure(ctx context.Context
 	}
 
 	r.providerData = providerData
+	if providerData.UAPClient != nil {
+		r.policyAPI = providerData.UAPClient.Db()
+	}
+}
+
+// ValidateConfig requires the profile block matching authentication_method, so a
+// missing profile fails at plan time instead of with an API error on apply
+func (r *DatabasePolicyDatabaseAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data models.DatabasePolicyDatabaseAssignmentModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authMethod := data.AuthenticationMethod.ValueString()
+	switch authMethod {
+	case "db_auth":
+		if data.DBAuthProfile == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("db_auth_profile"),
+				"Missing Authentication Profile",
+				"db_auth_profile block is required when authentication_method is 'db_auth'",
+			)
+		}
+	case "ldap_auth":
+		if data.LDAPAuthProfile == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ldap_auth_profile"),
+				"Missing Authentication Profile",
+				"ldap_auth_profile block is required when authentication_method is 'ldap_auth'",
+			)
+		}
+	case "oracle_auth":
+		if data.OracleAuthProfile == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oracle_auth_profile"),
+				"Missing Authentication Profile",
+				"oracle_auth_profile block is required when authentication_method is 'oracle_auth'",
+			)
+		}
+	case "mongo_auth":
+		if data.MongoAuthProfile == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("mongo_auth_profile"),
+				"Missing Authentication Profile",
+				"mongo_auth_profile block is required when authentication_method is 'mongo_auth'",
+			)
+		}
+	case "sqlserver_auth":
+		if data.SQLServerAuthProfile == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sqlserver_auth_profile"),
+				"Missing Authentication Profile",
+				"sqlserver_auth_profile block is required when authentication_method is 'sqlserver_auth'",
+			)
+		}
+	case "rds_iam_user_auth":
+		if data.RDSIAMUserAuthProfile == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rds_iam_user_auth_profile"),
+				"Missing Authentication Profile",
+				"rds_iam_user_auth_profile block is required when authentication_method is 'rds_iam_user_auth'",
+			)
+		}
+	}
 }
 
 func (r *DatabasePolicyDatabaseAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -216,6 +293,14 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Create(ctx context.Context, r
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	LogOperationStart(ctx, "create", "policy_database_assignment")
 
 	policyID := data.PolicyID.ValueString()
@@ -224,10 +309,10 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Create(ctx context.Context, r
 
 	// Step 1: Fetch existing policy (READ-MODIFY-WRITE pattern)
 	tflog.Debug(ctx, "Fetching policy", map[string]interface{}{
-		"policy_id": policyID,
+		logKeyPolicyID: policyID,
 	})
 
-	policy, err := r.providerData.UAPClient.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+	policy, err := r.policyAPI.Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
 		PolicyID: policyID,
 	})
 	if err != nil {
@@ -237,7 +322,7 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Create(ctx context.Context, r
 
 	// DEBUG: Log fetched policy structure
 	tflog.Debug(ctx, "Fetched policy structure", map[string]interface{}{
-		"policy_id":        policy.Metadata.PolicyID,
+		logKeyPolicyID:     policy.Metadata.PolicyID,
 		"policy_name":      policy.Metadata.Name,
 		"targets_count":    len(policy.Targets),
 		"principals_count": len(policy.Principals),
@@ -254,7 +339,7 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Create(ctx context.Context, r
 
 	// Step 2: Fetch database workspace (get InstanceName, InstanceType, InstanceID, Platform)
 	tflog.Debug(ctx, "Fetching database workspace", map[string]interface{}{
-		"database_id": databaseID,
+		logKeyDatabaseID: databaseID,
 	})
 
 	// Convert string to int for database fetch
@@ -301,8 +386,8 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Create(ctx context.Context, r
 	existingTarget := findDatabaseInPolicy(policy, strconv.Itoa(database.ID))
 	if existingTarget != nil {
 		tflog.Info(ctx, "Database already exists in policy - adopting existing configuration", map[string]interface{}{
-			"policy_id":   policyID,
-			"database_id": databaseID,
+			logKeyPolicyID:   policyID,
+			logKeyDatabaseID: databaseID,
 		})
 
 		// IDEMPOTENT: Adopt existing configuration
@@ -359,12 +444,8 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Create(ctx context.Context, r
 		},
 	}
 
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
-		_, updateErr := r.providerData.UAPClient.Db().UpdatePolicy(updatePolicy)
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
+		_, updateErr := r.policyAPI.UpdatePolicy(updatePolicy)
 		return updateErr
 	})
 
@@ -392,6 +473,14 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Read(ctx context.Context, req
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	LogOperationStart(ctx, "read", "policy_database_assignment")
 
 	// Step 1: Parse composite ID
@@ -406,13 +495,25 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Read(ctx context.Context, req
 
 	// Step 2: Fetch policy
 	tflog.Debug(ctx, "Fetching policy for read", map[string]interface{}{
-		"policy_id": policyID,
+		logKeyPolicyID: policyID,
 	})
 
-	policy, err := r.providerData.UAPClient.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+	policy, err := r.policyAPI.Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
 		PolicyID: policyID,
 	})
 	if err != nil {
+		// Parent policy deleted outside Terraform - assignment is gone with it
+		if client.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Policy not found - removing assignment from state", map[string]interface{}{
+				logKeyPolicyID: policyID,
+			})
+			LogDriftDetected(ctx, "policy_database_assignment", data.ID.ValueString(), map[string]interface{}{
+				logKeyPolicyID:   policyID,
+				logKeyDatabaseID: databaseID,
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.Append(client.MapError(err, "fetch policy"))
 		return
 	}
@@ -421,10 +522,13 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Read(ctx context.Context, req
 	target, _, found := findDatabaseInPolicyWithType(policy, databaseID)
 	if !found {
 		tflog.Warn(ctx, "Database not found in policy - resource deleted outside Terraform", map[string]interface{}{
-			"policy_id":   policyID,
-			"database_id": databaseID,
+			logKeyPolicyID:   policyID,
+			logKeyDatabaseID: databaseID,
+		})
+		LogDriftDetected(ctx, "policy_database_assignment", data.ID.ValueString(), map[string]interface{}{
+			logKeyPolicyID:   policyID,
+			logKeyDatabaseID: databaseID,
 		})
-		LogDriftDetected(ctx, "policy_database_assignment", data.ID.ValueString())
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -444,9 +548,12 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Read(ctx context.Context, req
 	if err != nil {
 		// Database workspace deleted - remove assignment
 		tflog.Warn(ctx, "Database workspace not found - removing assignment", map[string]interface{}{
-			"database_id": databaseID,
+			logKeyDatabaseID: databaseID,
+		})
+		LogDriftDetected(ctx, "policy_database_assignment", data.ID.ValueString(), map[string]interface{}{
+			logKeyPolicyID:   policyID,
+			logKeyDatabaseID: databaseID,
 		})
-		LogDriftDetected(ctx, "policy_database_assignment", data.ID.ValueString())
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -481,6 +588,14 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Update(ctx context.Context, r
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	LogOperationStart(ctx, "update", "policy_database_assignment")
 
 	// Step 1: Parse composite ID
@@ -495,10 +610,10 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Update(ctx context.Context, r
 
 	// Step 2: Fetch policy (READ-MODIFY-WRITE pattern)
 	tflog.Debug(ctx, "Fetching policy for update", map[string]interface{}{
-		"policy_id": policyID,
+		logKeyPolicyID: policyID,
 	})
 
-	policy, err := r.providerData.UAPClient.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+	policy, err := r.policyAPI.Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
 		PolicyID: policyID,
 	})
 	if err != nil {
@@ -540,9 +655,9 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Update(ctx context.Context, r
 	policy.Targets[workspaceType] = targets
 
 	tflog.Debug(ctx, "Updated database assignment in policy", map[string]interface{}{
-		"policy_id":   policyID,
-		"database_id": databaseID,
-		"auth_method": authMethod,
+		logKeyPolicyID:   policyID,
+		logKeyDatabaseID: databaseID,
+		"auth_method":    authMethod,
 	})
 
 	// Step 5: Write policy back with modified workspace type
@@ -555,12 +670,8 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Update(ctx context.Context, r
 		},
 	}
 
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
-		_, updateErr := r.providerData.UAPClient.Db().UpdatePolicy(updatePolicy)
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
+		_, updateErr := r.policyAPI.UpdatePolicy(updatePolicy)
 		return updateErr
 	})
 
@@ -587,6 +698,14 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Delete(ctx context.Context, r
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	LogOperationStart(ctx, "delete", "policy_database_assignment")
 
 	// Step 1: Parse composite ID
@@ -601,15 +720,15 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Delete(ctx context.Context, r
 
 	// Step 2: Fetch policy (READ-MODIFY-WRITE pattern)
 	tflog.Debug(ctx, "Fetching policy for delete", map[string]interface{}{
-		"policy_id": policyID,
+		logKeyPolicyID: policyID,
 	})
 
-	policy, err := r.providerData.UAPClient.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+	policy, err := r.policyAPI.Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
 		PolicyID: policyID,
 	})
 	if err != nil {
 		// If policy not found, resource is already gone - success
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if client.IsNotFoundError(err) {
 			tflog.Info(ctx, "Policy not found - considering delete successful")
 			return
 		}
@@ -622,8 +741,8 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Delete(ctx context.Context, r
 	if !found {
 		// Database not in policy - already deleted, consider success
 		tflog.Info(ctx, "Database not found in policy - considering delete successful", map[string]interface{}{
-			"policy_id":   policyID,
-			"database_id": databaseID,
+			logKeyPolicyID:   policyID,
+			logKeyDatabaseID: databaseID,
 		})
 		return
 	}
@@ -640,8 +759,8 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Delete(ctx context.Context, r
 	policy.Targets[workspaceType] = targets
 
 	tflog.Debug(ctx, "Removed database from policy targets", map[string]interface{}{
-		"policy_id":       policyID,
-		"database_id":     databaseID,
+		logKeyPolicyID:    policyID,
+		logKeyDatabaseID:  databaseID,
 		"workspace_type":  workspaceType,
 		"remaining_count": len(newInstances),
 	})
@@ -655,12 +774,8 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Delete(ctx context.Context, r
 		},
 	}
 
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
-		_, updateErr := r.providerData.UAPClient.Db().UpdatePolicy(updatePolicy)
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
+		_, updateErr := r.policyAPI.UpdatePolicy(updatePolicy)
 		return updateErr
 	})
 
@@ -672,9 +787,23 @@ func (r *DatabasePolicyDatabaseAssignmentResource) Delete(ctx context.Context, r
 	LogOperationSuccess(ctx, "delete", "policy_database_assignment", data.ID.ValueString())
 }
 
+// policyDatabaseImportIDPattern matches policy-id:database-id, where the policy ID is a
+// UUID in either case (the SIA UI shows them uppercase) and the database workspace ID is numeric
+var policyDatabaseImportIDPattern = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}:\d+$`)
+
 func (r *DatabasePolicyDatabaseAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: policy-id:database-id
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Reject malformed IDs here; Read would otherwise store them as-is
+	if !policyDatabaseImportIDPattern.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID in the format policy-id:database-id "+
+				"(e.g. 12345678-1234-1234-1234-123456789012:101), got: %q", req.ID),
+		)
+		return
+	}
+
+	// The API returns lowercase policy IDs, so store the same spelling
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.ToLower(req.ID))...)
 }
 
 // Helper functions (Tasks 13-14)
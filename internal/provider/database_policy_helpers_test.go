@@ -0,0 +1,951 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
+	dbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/sia/workspaces/db/models"
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policyModelCmpOptions compares framework values by their Equal method (they have unexported fields)
+var policyModelCmpOptions = cmp.Options{
+	cmp.Comparer(func(a, b types.String) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Int64) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Bool) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.List) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Set) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Map) bool { return a.Equal(b) }),
+	cmp.Comparer(func(a, b types.Object) bool { return a.Equal(b) }),
+}
+
+// Test that a policy model survives the same conversions the resource performs:
+// ToSDK + buildInstanceTarget on write, FromSDK + inlineTargetsFromPolicy on read
+func TestDatabasePolicyModel_SDKRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	stringList := func(values ...string) types.List {
+		elems := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	roleListType := types.ListType{ElemType: types.StringType}
+
+	databases := map[string]*dbmodels.ArkSIADBDatabase{
+		"101": {ID: 101, Name: "postgres-prod", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypePostgres}},
+		"102": {ID: 102, Name: "oracle-prod", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypeOracle}},
+		"103": {ID: 103, Name: "mongo-prod", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypeMongo}},
+		"104": {ID: 104, Name: "mssql-prod", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypeMSSQL}},
+	}
+
+	tests := []struct {
+		name  string
+		model models.DatabasePolicyModel
+	}{
+		{
+			name: "all fields populated",
+			model: models.DatabasePolicyModel{
+				ID:                       types.StringValue("11111111-2222-3333-4444-555555555555"),
+				PolicyID:                 types.StringValue("11111111-2222-3333-4444-555555555555"),
+				Name:                     types.StringValue("full-policy"),
+				Description:              types.StringValue("Policy with every field set"),
+				Status:                   types.StringValue("active"),
+				DelegationClassification: types.StringValue("unrestricted"),
+				TimeZone:                 types.StringValue("Europe/Amsterdam"),
+				PolicyTags:               stringList("prod", "dba"),
+				CreatedBy:                types.ObjectNull(models.ChangeInfoAttrTypes()),
+				UpdatedOn:                types.ObjectNull(models.ChangeInfoAttrTypes()),
+				TimeFrame: &models.TimeFrameModel{
+					FromTime: types.StringValue("2025-01-01T00:00:00Z"),
+					ToTime:   types.StringValue("2025-12-31T23:59:59Z"),
+				},
+				Conditions: &models.ConditionsModel{
+					MaxSessionDuration: types.Int64Value(4),
+					IdleTime:           types.Int64Value(30),
+					AccessWindow: &models.AccessWindowModel{
+						DaysOfTheWeek: types.SetValueMust(types.Int64Type, []attr.Value{
+							types.Int64Value(1), types.Int64Value(3), types.Int64Value(5),
+						}),
+						FromHour: types.StringValue("08:00"),
+						ToHour:   types.StringValue("18:00"),
+					},
+				},
+				Principal: []models.InlinePrincipalModel{
+					{
+						PrincipalID:         types.StringValue("c2c7bcc6-9560-44e0-8dff-5be221cd37ee"),
+						PrincipalType:       types.StringValue("USER"),
+						PrincipalName:       types.StringValue("alice@cyberark.cloud.12345"),
+						SourceDirectoryName: types.StringValue("CyberArk Cloud Directory"),
+						SourceDirectoryID:   types.StringValue("09B9A9B0-6CE8-465F-AB03-65766D33B05E"),
+					},
+					{
+						PrincipalID:         types.StringValue("d3d8cdd7-0671-55f1-9e00-6cf332de48ff"),
+						PrincipalType:       types.StringValue("GROUP"),
+						PrincipalName:       types.StringValue("Database Administrators"),
+						SourceDirectoryName: types.StringValue("Federation with cyberiam.com"),
+						SourceDirectoryID:   types.StringValue("aa22bb33-cc44-dd55-ee66-ff7788990011"),
+					},
+					{
+						PrincipalID:         types.StringValue("e4e9dee8-1782-66a2-af11-7d0443ef5900"),
+						PrincipalType:       types.StringValue("ROLE"),
+						PrincipalName:       types.StringValue("DB Auditors"),
+						SourceDirectoryName: types.StringNull(),
+						SourceDirectoryID:   types.StringNull(),
+					},
+				},
+				TargetDatabase: []models.InlineDatabaseAssignmentModel{
+					{
+						DatabaseWorkspaceID:  types.StringValue("101"),
+						AuthenticationMethod: types.StringValue("db_auth"),
+						DBAuthProfile:        &models.DBAuthProfileModel{Roles: stringList("db_reader", "db_writer")},
+					},
+					{
+						DatabaseWorkspaceID:  types.StringValue("102"),
+						AuthenticationMethod: types.StringValue("oracle_auth"),
+						OracleAuthProfile: &models.OracleAuthProfileModel{
+							Roles:       stringList("CONNECT"),
+							DbaRole:     types.BoolValue(true),
+							SysdbaRole:  types.BoolValue(false),
+							SysoperRole: types.BoolValue(false),
+						},
+					},
+					{
+						DatabaseWorkspaceID:  types.StringValue("103"),
+						AuthenticationMethod: types.StringValue("mongo_auth"),
+						MongoAuthProfile: &models.MongoAuthProfileModel{
+							GlobalBuiltinRoles: stringList("readAnyDatabase"),
+							DatabaseBuiltinRoles: types.MapValueMust(roleListType, map[string]attr.Value{
+								"db1": stringList("read"),
+								"db2": stringList("readWrite", "dbAdmin"),
+							}),
+							DatabaseCustomRoles: types.MapNull(roleListType),
+						},
+					},
+					{
+						DatabaseWorkspaceID:  types.StringValue("104"),
+						AuthenticationMethod: types.StringValue("sqlserver_auth"),
+						SQLServerAuthProfile: &models.SQLServerAuthProfileModel{
+							GlobalBuiltinRoles: stringList("sysadmin"),
+							GlobalCustomRoles:  types.ListNull(types.StringType),
+							DatabaseBuiltinRoles: types.MapValueMust(roleListType, map[string]attr.Value{
+								"Production": stringList("db_owner"),
+							}),
+							DatabaseCustomRoles: types.MapValueMust(roleListType, map[string]attr.Value{
+								"Production": stringList("app_reporter"),
+							}),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "minimal fields",
+			model: models.DatabasePolicyModel{
+				ID:                       types.StringValue("66666666-7777-8888-9999-000000000000"),
+				PolicyID:                 types.StringValue("66666666-7777-8888-9999-000000000000"),
+				Name:                     types.StringValue("minimal-policy"),
+				Description:              types.StringNull(),
+				Status:                   types.StringValue("suspended"),
+				DelegationClassification: types.StringValue("unrestricted"),
+				TimeZone:                 types.StringValue("GMT"),
+				PolicyTags:               types.ListNull(types.StringType),
+				CreatedBy:                types.ObjectNull(models.ChangeInfoAttrTypes()),
+				UpdatedOn:                types.ObjectNull(models.ChangeInfoAttrTypes()),
+				Conditions: &models.ConditionsModel{
+					MaxSessionDuration: types.Int64Value(8),
+					IdleTime:           types.Int64Value(10),
+				},
+				Principal: []models.InlinePrincipalModel{
+					{
+						PrincipalID:         types.StringValue("c2c7bcc6-9560-44e0-8dff-5be221cd37ee"),
+						PrincipalType:       types.StringValue("USER"),
+						PrincipalName:       types.StringValue("alice@cyberark.cloud.12345"),
+						SourceDirectoryName: types.StringValue("CyberArk Cloud Directory"),
+						SourceDirectoryID:   types.StringValue("09B9A9B0-6CE8-465F-AB03-65766D33B05E"),
+					},
+				},
+				TargetDatabase: []models.InlineDatabaseAssignmentModel{
+					{
+						DatabaseWorkspaceID:  types.StringValue("101"),
+						AuthenticationMethod: types.StringValue("db_auth"),
+						DBAuthProfile:        &models.DBAuthProfileModel{Roles: stringList("readonly")},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Write path (mirrors Create/Update)
+			policy := tt.model.ToSDK()
+			policy.Targets = make(map[string]uapsiadbmodels.ArkUAPSIADBTargets)
+			for i, targetDB := range tt.model.TargetDatabase {
+				instanceTarget, err := buildInstanceTarget(ctx, databases[targetDB.DatabaseWorkspaceID.ValueString()], targetDB, &diag.Diagnostics{})
+				if err != nil {
+					t.Fatalf("target_database[%d]: %s", i, err)
+				}
+				targets := policy.Targets["FQDN/IP"]
+				targets.Instances = append(targets.Instances, *instanceTarget)
+				policy.Targets["FQDN/IP"] = targets
+			}
+
+			// Read path (mirrors Read)
+			var got models.DatabasePolicyModel
+			if err := got.FromSDK(ctx, policy); err != nil {
+				t.Fatalf("FromSDK() error = %s", err)
+			}
+			var diags diag.Diagnostics
+			got.TargetDatabase = inlineTargetsFromPolicy(ctx, policy, nil, &diags)
+			if diags.HasError() {
+				t.Fatalf("inlineTargetsFromPolicy() diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(tt.model, got, policyModelCmpOptions); diff != "" {
+				t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// Test that a policy without database assignments (nil or empty Targets) converts without
+// error and yields no target_database blocks
+func TestDatabasePolicyModel_FromSDKEmptyTargets(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		targets map[string]uapsiadbmodels.ArkUAPSIADBTargets
+	}{
+		{name: "nil targets", targets: nil},
+		{name: "empty targets", targets: map[string]uapsiadbmodels.ArkUAPSIADBTargets{}},
+		{name: "workspace type without instances", targets: map[string]uapsiadbmodels.ArkUAPSIADBTargets{"FQDN/IP": {}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := validateConfigPolicyModel()
+			policy := model.ToSDK()
+			policy.Targets = tt.targets
+
+			var got models.DatabasePolicyModel
+			if err := got.FromSDK(ctx, policy); err != nil {
+				t.Fatalf("FromSDK() error = %s", err)
+			}
+
+			var diags diag.Diagnostics
+			got.TargetDatabase = inlineTargetsFromPolicy(ctx, policy, nil, &diags)
+			if diags.HasError() {
+				t.Fatalf("inlineTargetsFromPolicy() diagnostics: %v", diags)
+			}
+			if len(got.TargetDatabase) != 0 {
+				t.Errorf("TargetDatabase = %+v, want empty", got.TargetDatabase)
+			}
+			if len(got.Principal) != 1 {
+				t.Errorf("Principal count = %d, want 1", len(got.Principal))
+			}
+		})
+	}
+}
+
+// Test that FromSDK handles a policy without principals and principals with empty optional fields
+func TestDatabasePolicyModel_FromSDKPrincipals(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		principals []uapcommonmodels.ArkUAPPrincipal
+		want       []models.InlinePrincipalModel
+	}{
+		{name: "nil principals", principals: nil, want: nil},
+		{name: "empty principals", principals: []uapcommonmodels.ArkUAPPrincipal{}, want: nil},
+		{
+			name: "principal with empty optional fields",
+			principals: []uapcommonmodels.ArkUAPPrincipal{
+				{ID: "e4e9dee8-1782-66a2-af11-7d0443ef5900", Type: "ROLE", Name: "DB Auditors"},
+			},
+			want: []models.InlinePrincipalModel{
+				{
+					PrincipalID:         types.StringValue("e4e9dee8-1782-66a2-af11-7d0443ef5900"),
+					PrincipalType:       types.StringValue("ROLE"),
+					PrincipalName:       types.StringValue("DB Auditors"),
+					SourceDirectoryName: types.StringNull(),
+					SourceDirectoryID:   types.StringNull(),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := validateConfigPolicyModel()
+			policy := model.ToSDK()
+			policy.Principals = tt.principals
+
+			var got models.DatabasePolicyModel
+			if err := got.FromSDK(ctx, policy); err != nil {
+				t.Fatalf("FromSDK() error = %s", err)
+			}
+			if diff := cmp.Diff(tt.want, got.Principal, policyModelCmpOptions); diff != "" {
+				t.Errorf("Principal mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// Test that ToSDK always sends access window days sorted ascending, regardless of the
+// order they were configured in, and that the days survive a FromSDK round trip
+func TestDatabasePolicyModel_AccessWindowDaysOrder(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		days []int64
+		want []int
+	}{
+		{name: "unsorted", days: []int64{5, 1, 3}, want: []int{1, 3, 5}},
+		{name: "reverse", days: []int64{6, 4, 2, 0}, want: []int{0, 2, 4, 6}},
+		{name: "already sorted", days: []int64{1, 2, 3, 4, 5}, want: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elems := make([]attr.Value, 0, len(tt.days))
+			for _, d := range tt.days {
+				elems = append(elems, types.Int64Value(d))
+			}
+
+			model := validateConfigPolicyModel()
+			model.Conditions = &models.ConditionsModel{
+				MaxSessionDuration: types.Int64Value(8),
+				IdleTime:           types.Int64Value(10),
+				AccessWindow: &models.AccessWindowModel{
+					DaysOfTheWeek: types.SetValueMust(types.Int64Type, elems),
+					FromHour:      types.StringValue("09:00"),
+					ToHour:        types.StringValue("17:00"),
+				},
+			}
+
+			// Call twice: the order sent to the API must not depend on set iteration
+			for i := 0; i < 2; i++ {
+				policy := model.ToSDK()
+				if diff := cmp.Diff(tt.want, policy.Conditions.AccessWindow.DaysOfTheWeek); diff != "" {
+					t.Fatalf("ToSDK() days mismatch (-want +got):\n%s", diff)
+				}
+			}
+
+			var got models.DatabasePolicyModel
+			if err := got.FromSDK(ctx, model.ToSDK()); err != nil {
+				t.Fatalf("FromSDK() error = %s", err)
+			}
+			if diff := cmp.Diff(model.Conditions, got.Conditions, policyModelCmpOptions); diff != "" {
+				t.Errorf("conditions round trip mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// Test that the instance ID written to the API is the workspace ID read back as database_workspace_id
+func TestBuildInstanceTarget_InstanceID(t *testing.T) {
+	database := &dbmodels.ArkSIADBDatabase{ID: 4242, Name: "pg", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypePostgres}}
+
+	target, err := buildInstanceTarget(context.Background(), database, models.InlineDatabaseAssignmentModel{
+		DatabaseWorkspaceID:  types.StringValue("4242"),
+		AuthenticationMethod: types.StringValue("db_auth"),
+		DBAuthProfile:        &models.DBAuthProfileModel{Roles: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("r")})},
+	}, &diag.Diagnostics{})
+	if err != nil {
+		t.Fatalf("buildInstanceTarget() error = %s", err)
+	}
+	if target.InstanceID != strconv.Itoa(database.ID) {
+		t.Errorf("InstanceID = %q, want %q", target.InstanceID, strconv.Itoa(database.ID))
+	}
+	if target.InstanceType != dbmodels.FamilyTypePostgres {
+		t.Errorf("InstanceType = %q, want %q", target.InstanceType, dbmodels.FamilyTypePostgres)
+	}
+}
+
+// Test that each authentication method sets only its own profile on the instance target
+func TestBuildInstanceTarget_AuthProfiles(t *testing.T) {
+	ctx := context.Background()
+	database := &dbmodels.ArkSIADBDatabase{ID: 7, Name: "db", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypePostgres}}
+	roles := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("reader")})
+	dbRoles := types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{"app": roles})
+
+	tests := []struct {
+		name   string
+		target models.InlineDatabaseAssignmentModel
+		check  func(t *testing.T, got *uapsiadbmodels.ArkUAPSIADBInstanceTarget)
+	}{
+		{
+			name: "db_auth",
+			target: models.InlineDatabaseAssignmentModel{
+				DBAuthProfile: &models.DBAuthProfileModel{Roles: roles},
+			},
+			check: func(t *testing.T, got *uapsiadbmodels.ArkUAPSIADBInstanceTarget) {
+				if got.DBAuthProfile == nil || !cmp.Equal(got.DBAuthProfile.Roles, []string{"reader"}) {
+					t.Errorf("DBAuthProfile = %+v", got.DBAuthProfile)
+				}
+			},
+		},
+		{
+			name: "ldap_auth",
+			target: models.InlineDatabaseAssignmentModel{
+				LDAPAuthProfile: &models.LDAPAuthProfileModel{AssignGroups: roles},
+			},
+			check: func(t *testing.T, got *uapsiadbmodels.ArkUAPSIADBInstanceTarget) {
+				if got.LDAPAuthProfile == nil || !cmp.Equal(got.LDAPAuthProfile.AssignGroups, []string{"reader"}) {
+					t.Errorf("LDAPAuthProfile = %+v", got.LDAPAuthProfile)
+				}
+			},
+		},
+		{
+			name: "oracle_auth",
+			target: models.InlineDatabaseAssignmentModel{
+				OracleAuthProfile: &models.OracleAuthProfileModel{
+					Roles:       roles,
+					DbaRole:     types.BoolValue(true),
+					SysdbaRole:  types.BoolValue(false),
+					SysoperRole: types.BoolValue(true),
+				},
+			},
+			check: func(t *testing.T, got *uapsiadbmodels.ArkUAPSIADBInstanceTarget) {
+				want := &uapsiadbmodels.ArkUAPSIADBOracleAuthProfile{Roles: []string{"reader"}, DbaRole: true, SysoperRole: true}
+				if diff := cmp.Diff(want, got.OracleAuthProfile); diff != "" {
+					t.Errorf("OracleAuthProfile mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "mongo_auth",
+			target: models.InlineDatabaseAssignmentModel{
+				MongoAuthProfile: &models.MongoAuthProfileModel{
+					GlobalBuiltinRoles:   roles,
+					DatabaseBuiltinRoles: dbRoles,
+					DatabaseCustomRoles:  types.MapNull(types.ListType{ElemType: types.StringType}),
+				},
+			},
+			check: func(t *testing.T, got *uapsiadbmodels.ArkUAPSIADBInstanceTarget) {
+				want := &uapsiadbmodels.ArkUAPSIADBMongoAuthProfile{
+					GlobalBuiltinRoles:   []string{"reader"},
+					DatabaseBuiltinRoles: map[string][]string{"app": {"reader"}},
+				}
+				if diff := cmp.Diff(want, got.MongoAuthProfile); diff != "" {
+					t.Errorf("MongoAuthProfile mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "sqlserver_auth",
+			target: models.InlineDatabaseAssignmentModel{
+				SQLServerAuthProfile: &models.SQLServerAuthProfileModel{
+					GlobalBuiltinRoles:   types.ListNull(types.StringType),
+					GlobalCustomRoles:    roles,
+					DatabaseBuiltinRoles: types.MapNull(types.ListType{ElemType: types.StringType}),
+					DatabaseCustomRoles:  dbRoles,
+				},
+			},
+			check: func(t *testing.T, got *uapsiadbmodels.ArkUAPSIADBInstanceTarget) {
+				want := &uapsiadbmodels.ArkUAPSIADBSqlServerAuthProfile{
+					GlobalCustomRoles:   []string{"reader"},
+					DatabaseCustomRoles: map[string][]string{"app": {"reader"}},
+				}
+				if diff := cmp.Diff(want, got.SQLServerAuthProfile); diff != "" {
+					t.Errorf("SQLServerAuthProfile mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: "rds_iam_user_auth",
+			target: models.InlineDatabaseAssignmentModel{
+				RDSIAMUserAuthProfile: &models.RDSIAMUserAuthProfileModel{DBUser: types.StringValue("iam_reader")},
+			},
+			check: func(t *testing.T, got *uapsiadbmodels.ArkUAPSIADBInstanceTarget) {
+				if got.RDSIAMUserAuthProfile == nil || got.RDSIAMUserAuthProfile.DBUser != "iam_reader" {
+					t.Errorf("RDSIAMUserAuthProfile = %+v", got.RDSIAMUserAuthProfile)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.target.DatabaseWorkspaceID = types.StringValue("7")
+			tt.target.AuthenticationMethod = types.StringValue(tt.name)
+
+			got, err := buildInstanceTarget(ctx, database, tt.target, &diag.Diagnostics{})
+			if err != nil {
+				t.Fatalf("buildInstanceTarget() error = %s", err)
+			}
+			if got.AuthenticationMethod != tt.name {
+				t.Errorf("AuthenticationMethod = %q, want %q", got.AuthenticationMethod, tt.name)
+			}
+			if set := setInstanceTargetProfiles(got); !cmp.Equal(set, []string{tt.name}) {
+				t.Errorf("profiles set = %v, want only %s", set, tt.name)
+			}
+			tt.check(t, got)
+		})
+	}
+}
+
+// Test that a missing profile block returns an error for every authentication method
+func TestBuildInstanceTarget_MissingProfile(t *testing.T) {
+	database := &dbmodels.ArkSIADBDatabase{ID: 7, Name: "db", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypePostgres}}
+
+	for _, authMethod := range []string{"db_auth", "ldap_auth", "oracle_auth", "mongo_auth", "sqlserver_auth", "rds_iam_user_auth"} {
+		t.Run(authMethod, func(t *testing.T) {
+			got, err := buildInstanceTarget(context.Background(), database, models.InlineDatabaseAssignmentModel{
+				DatabaseWorkspaceID:  types.StringValue("7"),
+				AuthenticationMethod: types.StringValue(authMethod),
+			}, &diag.Diagnostics{})
+			if err == nil {
+				t.Fatalf("buildInstanceTarget() = %+v, want error for missing %s_profile", got, authMethod)
+			}
+			if want := authMethod + "_profile block is required"; !strings.Contains(err.Error(), want) {
+				t.Errorf("error = %q, want it to contain %q", err, want)
+			}
+		})
+	}
+}
+
+// Test that an unrecognized authentication method warns and still builds a minimal target
+func TestBuildInstanceTarget_UnknownAuthMethod(t *testing.T) {
+	database := &dbmodels.ArkSIADBDatabase{ID: 7, Name: "db", ProviderDetails: dbmodels.ArkSIADBDatabaseProvider{Family: dbmodels.FamilyTypePostgres}}
+
+	var diags diag.Diagnostics
+	got, err := buildInstanceTarget(context.Background(), database, models.InlineDatabaseAssignmentModel{
+		DatabaseWorkspaceID:  types.StringValue("7"),
+		AuthenticationMethod: types.StringValue("future_auth"),
+		DBAuthProfile:        &models.DBAuthProfileModel{Roles: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("r")})},
+	}, &diags)
+	if err != nil {
+		t.Fatalf("buildInstanceTarget() error = %s, want a warning only", err)
+	}
+
+	want := &uapsiadbmodels.ArkUAPSIADBInstanceTarget{
+		InstanceName:         "db",
+		InstanceType:         dbmodels.FamilyTypePostgres,
+		InstanceID:           "7",
+		AuthenticationMethod: "future_auth",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("buildInstanceTarget() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diags.HasError() || len(diags.Warnings()) != 1 {
+		t.Fatalf("diagnostics = %v, want exactly one warning", diags)
+	}
+	if summary := diags.Warnings()[0].Summary(); summary != "Unrecognized Authentication Method" {
+		t.Errorf("warning summary = %q, want %q", summary, "Unrecognized Authentication Method")
+	}
+	if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, `"future_auth"`) {
+		t.Errorf("warning detail = %q, want it to name the method", detail)
+	}
+}
+
+// setInstanceTargetProfiles returns the authentication methods whose profile is set on target
+func setInstanceTargetProfiles(target *uapsiadbmodels.ArkUAPSIADBInstanceTarget) []string {
+	var set []string
+	if target.DBAuthProfile != nil {
+		set = append(set, "db_auth")
+	}
+	if target.LDAPAuthProfile != nil {
+		set = append(set, "ldap_auth")
+	}
+	if target.OracleAuthProfile != nil {
+		set = append(set, "oracle_auth")
+	}
+	if target.MongoAuthProfile != nil {
+		set = append(set, "mongo_auth")
+	}
+	if target.SQLServerAuthProfile != nil {
+		set = append(set, "sqlserver_auth")
+	}
+	if target.RDSIAMUserAuthProfile != nil {
+		set = append(set, "rds_iam_user_auth")
+	}
+	return set
+}
+
+// validateConfigPolicyModel returns a policy model that passes ValidateConfig
+func validateConfigPolicyModel() models.DatabasePolicyModel {
+	return models.DatabasePolicyModel{
+		Name:                     types.StringValue("validate-policy"),
+		Status:                   types.StringValue("active"),
+		DelegationClassification: types.StringValue("unrestricted"),
+		TimeZone:                 types.StringValue("GMT"),
+		PolicyTags:               types.ListNull(types.StringType),
+		CreatedBy:                types.ObjectNull(models.ChangeInfoAttrTypes()),
+		UpdatedOn:                types.ObjectNull(models.ChangeInfoAttrTypes()),
+		Timeouts:                 nullTimeouts(),
+		Principal: []models.InlinePrincipalModel{
+			{
+				PrincipalID:         types.StringValue("c2c7bcc6-9560-44e0-8dff-5be221cd37ee"),
+				PrincipalType:       types.StringValue("ROLE"),
+				PrincipalName:       types.StringValue("DB Auditors"),
+				SourceDirectoryName: types.StringNull(),
+				SourceDirectoryID:   types.StringNull(),
+			},
+		},
+		TargetDatabase: []models.InlineDatabaseAssignmentModel{
+			{
+				DatabaseWorkspaceID:  types.StringValue("101"),
+				AuthenticationMethod: types.StringValue("db_auth"),
+				DBAuthProfile: &models.DBAuthProfileModel{
+					Roles: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("reader")}),
+				},
+			},
+		},
+	}
+}
+
+// validateDatabasePolicyConfig runs ValidateConfig against the model and returns the diagnostics
+func validateDatabasePolicyConfig(t *testing.T, model models.DatabasePolicyModel) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &DatabasePolicyResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema() diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	// Config has no setter; build the raw value through State
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("building config: %v", diags)
+	}
+
+	resp := resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+	}, &resp)
+	return resp.Diagnostics
+}
+
+// Test ValidateConfig source directory requirements for each principal type
+func TestDatabasePolicyResource_ValidateConfigPrincipals(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  models.InlinePrincipalModel
+		wantErrors []string
+	}{
+		{
+			name: "USER with source directory",
+			principal: models.InlinePrincipalModel{
+				PrincipalType:       types.StringValue("USER"),
+				SourceDirectoryName: types.StringValue("CyberArk Cloud Directory"),
+				SourceDirectoryID:   types.StringValue("09B9A9B0-6CE8-465F-AB03-65766D33B05E"),
+			},
+		},
+		{
+			name: "USER missing source_directory_name",
+			principal: models.InlinePrincipalModel{
+				PrincipalType:       types.StringValue("USER"),
+				SourceDirectoryName: types.StringNull(),
+				SourceDirectoryID:   types.StringValue("09B9A9B0-6CE8-465F-AB03-65766D33B05E"),
+			},
+			wantErrors: []string{"Missing Source Directory Name"},
+		},
+		{
+			name: "USER missing source_directory_id",
+			principal: models.InlinePrincipalModel{
+				PrincipalType:       types.StringValue("USER"),
+				SourceDirectoryName: types.StringValue("CyberArk Cloud Directory"),
+				SourceDirectoryID:   types.StringNull(),
+			},
+			wantErrors: []string{"Missing Source Directory ID"},
+		},
+		{
+			name: "GROUP with source directory",
+			principal: models.InlinePrincipalModel{
+				PrincipalType:       types.StringValue("GROUP"),
+				SourceDirectoryName: types.StringValue("CyberArk Cloud Directory"),
+				SourceDirectoryID:   types.StringValue("09B9A9B0-6CE8-465F-AB03-65766D33B05E"),
+			},
+		},
+		{
+			name: "GROUP missing source directory",
+			principal: models.InlinePrincipalModel{
+				PrincipalType:       types.StringValue("GROUP"),
+				SourceDirectoryName: types.StringNull(),
+				SourceDirectoryID:   types.StringNull(),
+			},
+			wantErrors: []string{"Missing Source Directory Name", "Missing Source Directory ID"},
+		},
+		{
+			name: "ROLE without source directory",
+			principal: models.InlinePrincipalModel{
+				PrincipalType:       types.StringValue("ROLE"),
+				SourceDirectoryName: types.StringNull(),
+				SourceDirectoryID:   types.StringNull(),
+			},
+		},
+		{
+			name: "ROLE with source directory",
+			principal: models.InlinePrincipalModel{
+				PrincipalType:       types.StringValue("ROLE"),
+				SourceDirectoryName: types.StringValue("CyberArk Cloud Directory"),
+				SourceDirectoryID:   types.StringValue("09B9A9B0-6CE8-465F-AB03-65766D33B05E"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := validateConfigPolicyModel()
+			principal := tt.principal
+			principal.PrincipalID = types.StringValue("c2c7bcc6-9560-44e0-8dff-5be221cd37ee")
+			principal.PrincipalName = types.StringValue("principal-name")
+			model.Principal = []models.InlinePrincipalModel{principal}
+
+			var gotErrors []string
+			for _, d := range validateDatabasePolicyConfig(t, model).Errors() {
+				gotErrors = append(gotErrors, d.Summary())
+			}
+			if diff := cmp.Diff(tt.wantErrors, gotErrors); diff != "" {
+				t.Errorf("ValidateConfig() error summaries mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// Test ValidateConfig requires the profile block matching authentication_method
+func TestDatabasePolicyResource_ValidateConfigAuthProfiles(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     models.InlineDatabaseAssignmentModel
+		wantDetail string
+	}{
+		{
+			name: "db_auth with profile",
+			target: models.InlineDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("db_auth"),
+				DBAuthProfile: &models.DBAuthProfileModel{
+					Roles: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("reader")}),
+				},
+			},
+		},
+		{
+			name: "db_auth without profile",
+			target: models.InlineDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("db_auth"),
+			},
+			wantDetail: "target_databases[0]: db_auth_profile block is required when authentication_method is 'db_auth'",
+		},
+		{
+			name: "rds_iam_user_auth without profile",
+			target: models.InlineDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("rds_iam_user_auth"),
+			},
+			wantDetail: "target_databases[0]: rds_iam_user_auth_profile block is required when authentication_method is 'rds_iam_user_auth'",
+		},
+		{
+			name: "mongo_auth without profile",
+			target: models.InlineDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("mongo_auth"),
+			},
+			wantDetail: "target_databases[0]: mongo_auth_profile block is required when authentication_method is 'mongo_auth'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := validateConfigPolicyModel()
+			target := tt.target
+			target.DatabaseWorkspaceID = types.StringValue("101")
+			model.TargetDatabase = []models.InlineDatabaseAssignmentModel{target}
+
+			errs := validateDatabasePolicyConfig(t, model).Errors()
+			if tt.wantDetail == "" {
+				if len(errs) != 0 {
+					t.Fatalf("ValidateConfig() unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("ValidateConfig() got %d errors, want 1: %v", len(errs), errs)
+			}
+			if errs[0].Summary() != "Missing Authentication Profile" {
+				t.Errorf("summary = %q, want %q", errs[0].Summary(), "Missing Authentication Profile")
+			}
+			if errs[0].Detail() != tt.wantDetail {
+				t.Errorf("detail = %q, want %q", errs[0].Detail(), tt.wantDetail)
+			}
+		})
+	}
+}
+
+// Test ValidateConfig requires RFC3339 time_frame timestamps with from_time before to_time
+func TestDatabasePolicyResource_ValidateConfigTimeFrame(t *testing.T) {
+	tests := []struct {
+		name       string
+		timeFrame  *models.TimeFrameModel
+		wantErrors []string
+	}{
+		{
+			name:      "ordered",
+			timeFrame: &models.TimeFrameModel{FromTime: types.StringValue("2025-01-01T00:00:00Z"), ToTime: types.StringValue("2025-12-31T23:59:59Z")},
+		},
+		{
+			name:      "ordered across offsets",
+			timeFrame: &models.TimeFrameModel{FromTime: types.StringValue("2025-01-01T10:00:00+02:00"), ToTime: types.StringValue("2025-01-01T09:00:00Z")},
+		},
+		{
+			name:       "inverted",
+			timeFrame:  &models.TimeFrameModel{FromTime: types.StringValue("2025-12-31T23:59:59Z"), ToTime: types.StringValue("2025-01-01T00:00:00Z")},
+			wantErrors: []string{"Invalid Time Frame"},
+		},
+		{
+			name:       "equal",
+			timeFrame:  &models.TimeFrameModel{FromTime: types.StringValue("2025-01-01T00:00:00Z"), ToTime: types.StringValue("2025-01-01T00:00:00Z")},
+			wantErrors: []string{"Invalid Time Frame"},
+		},
+		{
+			name:       "from_time not RFC3339",
+			timeFrame:  &models.TimeFrameModel{FromTime: types.StringValue("2025-01-01"), ToTime: types.StringValue("2025-12-31T23:59:59Z")},
+			wantErrors: []string{"Invalid Time Format"},
+		},
+		{
+			name:       "both not RFC3339",
+			timeFrame:  &models.TimeFrameModel{FromTime: types.StringValue("2025-01-01 00:00:00"), ToTime: types.StringValue("tomorrow")},
+			wantErrors: []string{"Invalid Time Format", "Invalid Time Format"},
+		},
+		{
+			name:      "to_time unset",
+			timeFrame: &models.TimeFrameModel{FromTime: types.StringValue("2025-01-01T00:00:00Z"), ToTime: types.StringNull()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := validateConfigPolicyModel()
+			model.TimeFrame = tt.timeFrame
+
+			var gotErrors []string
+			for _, d := range validateDatabasePolicyConfig(t, model).Errors() {
+				gotErrors = append(gotErrors, d.Summary())
+			}
+			if diff := cmp.Diff(tt.wantErrors, gotErrors); diff != "" {
+				t.Errorf("ValidateConfig() error summaries mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// Test ValidateConfig requires access_window from_hour to be earlier than to_hour
+func TestDatabasePolicyResource_ValidateConfigAccessWindowOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		fromHour   string
+		toHour     string
+		wantErrors []string
+	}{
+		{name: "business hours", fromHour: "09:00", toHour: "17:00"},
+		{name: "full day", fromHour: "00:00", toHour: "23:59"},
+		{name: "one minute", fromHour: "12:00", toHour: "12:01"},
+		{name: "inverted", fromHour: "17:00", toHour: "09:00", wantErrors: []string{"Invalid Access Window Configuration"}},
+		{name: "equal", fromHour: "09:00", toHour: "09:00", wantErrors: []string{"Invalid Access Window Configuration"}},
+		{name: "spans midnight", fromHour: "22:00", toHour: "06:00", wantErrors: []string{"Invalid Access Window Configuration"}},
+		{name: "malformed is left to the attribute validator", fromHour: "9am", toHour: "09:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := validateConfigPolicyModel()
+			model.Conditions = &models.ConditionsModel{
+				MaxSessionDuration: types.Int64Value(8),
+				IdleTime:           types.Int64Value(10),
+				AccessWindow: &models.AccessWindowModel{
+					DaysOfTheWeek: types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)}),
+					FromHour:      types.StringValue(tt.fromHour),
+					ToHour:        types.StringValue(tt.toHour),
+				},
+			}
+
+			var gotErrors []string
+			for _, d := range validateDatabasePolicyConfig(t, model).Errors() {
+				gotErrors = append(gotErrors, d.Summary())
+			}
+			if diff := cmp.Diff(tt.wantErrors, gotErrors); diff != "" {
+				t.Errorf("ValidateConfig() error summaries mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMinutesSinceMidnight(t *testing.T) {
+	tests := []struct {
+		hhmm   string
+		want   int
+		wantOK bool
+	}{
+		{hhmm: "00:00", want: 0, wantOK: true},
+		{hhmm: "09:30", want: 570, wantOK: true},
+		{hhmm: "23:59", want: 1439, wantOK: true},
+		{hhmm: "24:00"},
+		{hhmm: "9:00am"},
+		{hhmm: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.hhmm, func(t *testing.T) {
+			got, ok := minutesSinceMidnight(tt.hhmm)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("minutesSinceMidnight(%q) = %d, %v; want %d, %v", tt.hhmm, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// Test that targets keep the prior target_database order, with new targets appended in
+// API order and removed targets dropped
+func TestOrderTargetsLike(t *testing.T) {
+	target := func(workspaceID string) models.InlineDatabaseAssignmentModel {
+		return models.InlineDatabaseAssignmentModel{
+			DatabaseWorkspaceID:  types.StringValue(workspaceID),
+			AuthenticationMethod: types.StringValue("db_auth"),
+		}
+	}
+	workspaceIDs := func(targets []models.InlineDatabaseAssignmentModel) []string {
+		ids := []string{}
+		for _, target := range targets {
+			ids = append(ids, target.DatabaseWorkspaceID.ValueString())
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name  string
+		prior []models.InlineDatabaseAssignmentModel
+		api   []models.InlineDatabaseAssignmentModel
+		want  []string
+	}{
+		{name: "no prior keeps API order", prior: nil, api: []models.InlineDatabaseAssignmentModel{target("2"), target("1")}, want: []string{"2", "1"}},
+		{name: "prior order kept", prior: []models.InlineDatabaseAssignmentModel{target("1"), target("2"), target("3")}, api: []models.InlineDatabaseAssignmentModel{target("3"), target("1"), target("2")}, want: []string{"1", "2", "3"}},
+		{name: "new targets appended", prior: []models.InlineDatabaseAssignmentModel{target("2")}, api: []models.InlineDatabaseAssignmentModel{target("4"), target("2"), target("3")}, want: []string{"2", "4", "3"}},
+		{name: "removed targets dropped", prior: []models.InlineDatabaseAssignmentModel{target("1"), target("2")}, api: []models.InlineDatabaseAssignmentModel{target("2")}, want: []string{"2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := workspaceIDs(orderTargetsLike(tt.prior, tt.api))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("orderTargetsLike() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -97,6 +97,10 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Schema(ctx context.Context,
 				Computed:            true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -125,6 +129,14 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Create(ctx context.Context,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Validate conditional requirements
 	if err := validatePrincipalDirectory(data.PrincipalType.ValueString(), data.SourceDirectoryName.ValueString(), data.SourceDirectoryID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Validation Error", err.Error())
@@ -160,11 +172,7 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Create(ctx context.Context,
 	policy.Principals = append(policy.Principals, newPrincipal)
 
 	// Update policy with retry
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		_, err := r.providerData.UAPClient.Db().UpdatePolicy(policy)
 		return err
 	})
@@ -178,9 +186,9 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Create(ctx context.Context,
 	data.FromSDKPrincipal(policyID, newPrincipal)
 
 	tflog.Info(ctx, "Created principal assignment", map[string]interface{}{
-		"policy_id":      policyID,
-		"principal_id":   principalID,
-		"principal_type": principalType,
+		logKeyPolicyID:    policyID,
+		logKeyPrincipalID: principalID,
+		"principal_type":  principalType,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -194,6 +202,14 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Read(ctx context.Context, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	policyID := data.PolicyID.ValueString()
 	principalID := data.PrincipalID.ValueString()
 	principalType := data.PrincipalType.ValueString()
@@ -227,9 +243,9 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Read(ctx context.Context, re
 	}
 
 	tflog.Debug(ctx, "Read principal assignment", map[string]interface{}{
-		"policy_id":      policyID,
-		"principal_id":   principalID,
-		"principal_type": principalType,
+		logKeyPolicyID:    policyID,
+		logKeyPrincipalID: principalID,
+		"principal_type":  principalType,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -243,6 +259,14 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Update(ctx context.Context,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Validate conditional requirements
 	if err := validatePrincipalDirectory(data.PrincipalType.ValueString(), data.SourceDirectoryName.ValueString(), data.SourceDirectoryID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Validation Error", err.Error())
@@ -281,11 +305,7 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Update(ctx context.Context,
 	}
 
 	// Update policy with retry
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		_, err := r.providerData.UAPClient.Db().UpdatePolicy(policy)
 		return err
 	})
@@ -299,9 +319,9 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Update(ctx context.Context,
 	data.FromSDKPrincipal(policyID, data.ToSDKPrincipal())
 
 	tflog.Info(ctx, "Updated principal assignment", map[string]interface{}{
-		"policy_id":      policyID,
-		"principal_id":   principalID,
-		"principal_type": principalType,
+		logKeyPolicyID:    policyID,
+		logKeyPrincipalID: principalID,
+		"principal_type":  principalType,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -315,6 +335,14 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Delete(ctx context.Context,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	policyID := data.PolicyID.ValueString()
 	principalID := data.PrincipalID.ValueString()
 	principalType := data.PrincipalType.ValueString()
@@ -350,11 +378,7 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Delete(ctx context.Context,
 	policy.Principals = newPrincipals
 
 	// Update policy with retry
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		_, err := r.providerData.UAPClient.Db().UpdatePolicy(policy)
 		return err
 	})
@@ -365,9 +389,9 @@ func (r *DatabasePolicyPrincipalAssignmentResource) Delete(ctx context.Context,
 	}
 
 	tflog.Info(ctx, "Deleted principal assignment", map[string]interface{}{
-		"policy_id":      policyID,
-		"principal_id":   principalID,
-		"principal_type": principalType,
+		logKeyPolicyID:    policyID,
+		logKeyPrincipalID: principalID,
+		"principal_type":  principalType,
 	})
 }
 
@@ -394,6 +418,7 @@ func (r *DatabasePolicyPrincipalAssignmentResource) ImportState(ctx context.Cont
 	for _, p := range policy.Principals {
 		if p.ID == principalID && p.Type == principalType {
 			data.FromSDKPrincipal(policyID, p)
+			data.Timeouts = nullTimeouts()
 			found = true
 			break
 		}
@@ -408,9 +433,9 @@ func (r *DatabasePolicyPrincipalAssignmentResource) ImportState(ctx context.Cont
 	}
 
 	tflog.Info(ctx, "Imported principal assignment", map[string]interface{}{
-		"policy_id":      policyID,
-		"principal_id":   principalID,
-		"principal_type": principalType,
+		logKeyPolicyID:    policyID,
+		logKeyPrincipalID: principalID,
+		"principal_type":  principalType,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
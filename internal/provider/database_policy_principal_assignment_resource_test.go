@@ -2,10 +2,14 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/provider/helpers"
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // ============================================================================
@@ -237,6 +241,155 @@ func TestAccPrincipalAssignment_forceNewAttributes(t *testing.T) {
 	})
 }
 
+// TestAccPrincipalAssignment_forceNewPolicyID tests that moving an assignment to another policy replaces it
+// Validates:
+// - Changing policy_id destroys and recreates the assignment (RequiresReplace)
+// - The composite ID changes to reference the new policy
+// - The API shows the principal removed from the original policy and added to the new one
+func TestAccPrincipalAssignment_forceNewPolicyID(t *testing.T) {
+	const assignmentName = "cyberarksia_database_policy_principal_assignment.move"
+	var assignmentID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Assign principal to policy "a"
+			{
+				Config: testAccPrincipalAssignmentConfigPolicyID("a"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(assignmentName, "policy_id", "cyberarksia_database_policy.move_a", "policy_id"),
+					testAccCaptureResourceID(assignmentName, &assignmentID),
+					testAccCheckPolicyHasPrincipal(t, "cyberarksia_database_policy.move_a", "data.cyberarksia_principal.move_user", true),
+					testAccCheckPolicyHasPrincipal(t, "cyberarksia_database_policy.move_b", "data.cyberarksia_principal.move_user", false),
+				),
+			},
+			// Step 2: Move assignment to policy "b" (forces replacement)
+			{
+				Config: testAccPrincipalAssignmentConfigPolicyID("b"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(assignmentName, "policy_id", "cyberarksia_database_policy.move_b", "policy_id"),
+					testAccCheckResourceIDChanged(assignmentName, &assignmentID),
+					testAccCheckPolicyHasPrincipal(t, "cyberarksia_database_policy.move_a", "data.cyberarksia_principal.move_user", false),
+					testAccCheckPolicyHasPrincipal(t, "cyberarksia_database_policy.move_b", "data.cyberarksia_principal.move_user", true),
+				),
+			},
+		},
+	})
+}
+
+// TestAccPrincipalAssignment_externalRemoval tests recovery from a principal removed outside Terraform
+// Validates:
+// - Read() removes the assignment from state when the principal is no longer in the policy
+// - The next plan is non-empty (assignment will be recreated)
+// - Apply restores the principal with the same composite ID
+func TestAccPrincipalAssignment_externalRemoval(t *testing.T) {
+	const assignmentName = "cyberarksia_database_policy_principal_assignment.external_removal"
+	var assignmentID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: Create assignment and capture its composite ID
+			{
+				Config: testAccPrincipalAssignmentConfigExternalRemoval,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCaptureResourceID(assignmentName, &assignmentID),
+					testAccCheckPolicyHasPrincipal(t, "cyberarksia_database_policy.external_removal", "data.cyberarksia_principal.external_removal_user", true),
+				),
+			},
+			// Step 2: Remove the principal from the policy via API, then expect a non-empty plan
+			{
+				PreConfig:          func() { testAccRemovePrincipalFromPolicy(t, assignmentID) },
+				Config:             testAccPrincipalAssignmentConfigExternalRemoval,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Step 3: Apply recreates the assignment
+			{
+				Config: testAccPrincipalAssignmentConfigExternalRemoval,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(assignmentName, "id", &assignmentID),
+					testAccCheckPolicyHasPrincipal(t, "cyberarksia_database_policy.external_removal", "data.cyberarksia_principal.external_removal_user", true),
+				),
+			},
+		},
+	})
+}
+
+// testAccRemovePrincipalFromPolicy removes a principal from its policy directly via the UAP API,
+// simulating an out-of-band change. Uses the same READ-MODIFY-WRITE pattern as Delete().
+func testAccRemovePrincipalFromPolicy(t *testing.T, compositeID string) {
+	t.Helper()
+
+	policyID, principalID, principalType, err := helpers.ParsePolicyPrincipalID(compositeID)
+	if err != nil {
+		t.Fatalf("failed to parse assignment ID: %s", err)
+	}
+
+	providerData := testAccProviderData(t)
+
+	policy, err := providerData.UAPClient.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+		PolicyID: policyID,
+	})
+	if err != nil {
+		t.Fatalf("failed to fetch policy %s: %s", policyID, err)
+	}
+
+	remaining := make([]uapcommonmodels.ArkUAPPrincipal, 0, len(policy.Principals))
+	for _, p := range policy.Principals {
+		if p.ID == principalID && p.Type == principalType {
+			continue
+		}
+		remaining = append(remaining, p)
+	}
+	if len(remaining) == len(policy.Principals) {
+		t.Fatalf("principal %s not found in policy %s", principalID, policyID)
+	}
+	policy.Principals = remaining
+
+	if _, err := providerData.UAPClient.Db().UpdatePolicy(policy); err != nil {
+		t.Fatalf("failed to remove principal %s from policy %s: %s", principalID, policyID, err)
+	}
+}
+
+// testAccCheckPolicyHasPrincipal verifies via the API whether a policy includes the principal
+// resolved by the given cyberarksia_principal data source
+func testAccCheckPolicyHasPrincipal(t *testing.T, policyResource, principalDataSource string, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[policyResource]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", policyResource)
+		}
+		ds, ok := s.RootModule().Resources[principalDataSource]
+		if !ok {
+			return fmt.Errorf("data source not found in state: %s", principalDataSource)
+		}
+		policyID := rs.Primary.ID
+		principalID := ds.Primary.ID
+
+		policy, err := testAccProviderData(t).UAPClient.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+			PolicyID: policyID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fetch policy %s: %w", policyID, err)
+		}
+
+		found := false
+		for _, p := range policy.Principals {
+			if p.ID == principalID {
+				found = true
+				break
+			}
+		}
+		if found != want {
+			return fmt.Errorf("policy %s: principal %s present = %t, want %t", policyID, principalID, found, want)
+		}
+		return nil
+	}
+}
+
 // ============================================================================
 // Test Configurations
 // ============================================================================
@@ -612,3 +765,173 @@ resource "cyberarksia_database_policy_principal_assignment" "forcenew_test" {
   source_directory_id   = data.cyberarksia_principal.group.directory_id
 }
 `
+
+const testAccPrincipalAssignmentConfigExternalRemoval = `
+resource "cyberarksia_secret" "external_removal" {
+  name                = "test-principal-external-removal-secret"
+  authentication_type = "local"
+  username            = "db_admin"
+  password            = "TestPassword123!"
+}
+
+resource "cyberarksia_database_workspace" "external_removal" {
+  name                  = "test-principal-external-removal-db"
+  database_type         = "postgres"
+  address               = "postgres-principal-external-removal.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = cyberarksia_secret.external_removal.id
+}
+
+data "cyberarksia_principal" "external_removal_anchor" {
+  name = "CyberArk Guardians"
+  type = "GROUP"
+}
+
+data "cyberarksia_principal" "external_removal_user" {
+  name = "tim.schindler@cyberark.cloud.40562"
+  type = "USER"
+}
+
+resource "cyberarksia_database_policy" "external_removal" {
+  name   = "test-principal-external-removal-policy"
+  status = "active"
+
+  conditions {
+    max_session_duration = 8
+  }
+
+  target_database {
+    database_workspace_id = cyberarksia_database_workspace.external_removal.id
+    authentication_method = "db_auth"
+
+    db_auth_profile {
+      roles = ["readonly"]
+    }
+  }
+
+  principal {
+    principal_id          = data.cyberarksia_principal.external_removal_anchor.id
+    principal_type        = data.cyberarksia_principal.external_removal_anchor.principal_type
+    principal_name        = data.cyberarksia_principal.external_removal_anchor.name
+    source_directory_name = data.cyberarksia_principal.external_removal_anchor.directory_name
+    source_directory_id   = data.cyberarksia_principal.external_removal_anchor.directory_id
+  }
+
+  lifecycle {
+    ignore_changes = [principal]
+  }
+}
+
+resource "cyberarksia_database_policy_principal_assignment" "external_removal" {
+  policy_id             = cyberarksia_database_policy.external_removal.policy_id
+  principal_id          = data.cyberarksia_principal.external_removal_user.id
+  principal_type        = data.cyberarksia_principal.external_removal_user.principal_type
+  principal_name        = data.cyberarksia_principal.external_removal_user.name
+  source_directory_name = data.cyberarksia_principal.external_removal_user.directory_name
+  source_directory_id   = data.cyberarksia_principal.external_removal_user.directory_id
+}
+`
+
+// testAccPrincipalAssignmentConfigPolicyID returns two policies (anchored by a GROUP principal)
+// and a USER assignment attached to policy "a" or "b"
+func testAccPrincipalAssignmentConfigPolicyID(policy string) string {
+	return fmt.Sprintf(`
+resource "cyberarksia_secret" "move" {
+  name                = "test-principal-move-secret"
+  authentication_type = "local"
+  username            = "db_admin"
+  password            = "TestPassword123!"
+}
+
+resource "cyberarksia_database_workspace" "move" {
+  name                  = "test-principal-move-db"
+  database_type         = "postgres"
+  address               = "postgres-principal-move.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = cyberarksia_secret.move.id
+}
+
+data "cyberarksia_principal" "move_anchor" {
+  name = "CyberArk Guardians"
+  type = "GROUP"
+}
+
+data "cyberarksia_principal" "move_user" {
+  name = "tim.schindler@cyberark.cloud.40562"
+  type = "USER"
+}
+
+resource "cyberarksia_database_policy" "move_a" {
+  name   = "test-principal-move-policy-a"
+  status = "active"
+
+  conditions {
+    max_session_duration = 8
+  }
+
+  target_database {
+    database_workspace_id = cyberarksia_database_workspace.move.id
+    authentication_method = "db_auth"
+
+    db_auth_profile {
+      roles = ["readonly"]
+    }
+  }
+
+  principal {
+    principal_id          = data.cyberarksia_principal.move_anchor.id
+    principal_type        = data.cyberarksia_principal.move_anchor.principal_type
+    principal_name        = data.cyberarksia_principal.move_anchor.name
+    source_directory_name = data.cyberarksia_principal.move_anchor.directory_name
+    source_directory_id   = data.cyberarksia_principal.move_anchor.directory_id
+  }
+
+  lifecycle {
+    ignore_changes = [principal]
+  }
+}
+
+resource "cyberarksia_database_policy" "move_b" {
+  name   = "test-principal-move-policy-b"
+  status = "active"
+
+  conditions {
+    max_session_duration = 8
+  }
+
+  target_database {
+    database_workspace_id = cyberarksia_database_workspace.move.id
+    authentication_method = "db_auth"
+
+    db_auth_profile {
+      roles = ["readonly"]
+    }
+  }
+
+  principal {
+    principal_id          = data.cyberarksia_principal.move_anchor.id
+    principal_type        = data.cyberarksia_principal.move_anchor.principal_type
+    principal_name        = data.cyberarksia_principal.move_anchor.name
+    source_directory_name = data.cyberarksia_principal.move_anchor.directory_name
+    source_directory_id   = data.cyberarksia_principal.move_anchor.directory_id
+  }
+
+  lifecycle {
+    ignore_changes = [principal]
+  }
+}
+
+resource "cyberarksia_database_policy_principal_assignment" "move" {
+  policy_id             = cyberarksia_database_policy.move_%s.policy_id
+  principal_id          = data.cyberarksia_principal.move_user.id
+  principal_type        = data.cyberarksia_principal.move_user.principal_type
+  principal_name        = data.cyberarksia_principal.move_user.name
+  source_directory_name = data.cyberarksia_principal.move_user.directory_name
+  source_directory_id   = data.cyberarksia_principal.move_user.directory_id
+}
+`, policy)
+}
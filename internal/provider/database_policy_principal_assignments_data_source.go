@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatabasePolicyPrincipalAssignmentsDataSource{}
+
+func NewDatabasePolicyPrincipalAssignmentsDataSource() datasource.DataSource {
+	return &DatabasePolicyPrincipalAssignmentsDataSource{}
+}
+
+// DatabasePolicyPrincipalAssignmentsDataSource defines the data source implementation.
+type DatabasePolicyPrincipalAssignmentsDataSource struct {
+	providerData *ProviderData
+}
+
+// DatabasePolicyPrincipalAssignmentsDataSourceModel describes the data source data model.
+type DatabasePolicyPrincipalAssignmentsDataSourceModel struct {
+	// Input
+	PolicyID types.String `tfsdk:"policy_id"`
+
+	// Computed
+	ID         types.String                  `tfsdk:"id"`
+	Principals []models.InlinePrincipalModel `tfsdk:"principals"`
+}
+
+// Metadata returns the data source type name.
+func (d *DatabasePolicyPrincipalAssignmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_policy_principal_assignments"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabasePolicyPrincipalAssignmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the principals currently assigned to a database access policy, without managing them. " +
+			"This is the read-only counterpart of `cyberarksia_database_policy_principal_assignment`, e.g. for inspecting " +
+			"a policy owned by another team.",
+
+		Attributes: map[string]schema.Attribute{
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the database access policy. Use `cyberarksia_database_policy.example.policy_id`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.UUID(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The policy ID (same as `policy_id`).",
+				Computed:            true,
+			},
+			"principals": schema.SetNestedAttribute{
+				MarkdownDescription: "Principals assigned to the policy.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"principal_id": schema.StringAttribute{
+							MarkdownDescription: "Principal identifier (UUID).",
+							Computed:            true,
+						},
+						"principal_type": schema.StringAttribute{
+							MarkdownDescription: "Principal type: `USER`, `GROUP`, or `ROLE`.",
+							Computed:            true,
+						},
+						"principal_name": schema.StringAttribute{
+							MarkdownDescription: "Principal name.",
+							Computed:            true,
+						},
+						"source_directory_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the directory the principal comes from. Null for `ROLE` principals.",
+							Computed:            true,
+						},
+						"source_directory_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the directory the principal comes from. Null for `ROLE` principals.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source with provider data.
+func (d *DatabasePolicyPrincipalAssignmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+// Read fetches the policy and returns its principals.
+func (d *DatabasePolicyPrincipalAssignmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.providerData == nil || d.providerData.UAPClient == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured ProviderData. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data DatabasePolicyPrincipalAssignmentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID := data.PolicyID.ValueString()
+
+	var policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy
+	err := client.RetryWithBackoff(ctx, d.providerData.retryConfig(), func() error {
+		var apiErr error
+		policy, apiErr = d.providerData.UAPClient.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{
+			PolicyID: policyID,
+		})
+		return apiErr
+	})
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Policy Not Found",
+				fmt.Sprintf("No policy found with ID %s. Ensure the policy exists and you have permission to read it.", policyID),
+			)
+			return
+		}
+		resp.Diagnostics.Append(client.MapError(err, "read policy principals"))
+		return
+	}
+
+	data.ID = types.StringValue(policyID)
+	data.Principals = principalsFromPolicy(policy)
+
+	tflog.Debug(ctx, "Read policy principal assignments", map[string]interface{}{
+		logKeyPolicyID:    policyID,
+		"principal_count": len(data.Principals),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// principalsFromPolicy returns the policy's principals, empty rather than nil so
+// an unassigned policy reads as an empty set instead of null
+func principalsFromPolicy(policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy) []models.InlinePrincipalModel {
+	principals := models.ConvertPrincipalsFromSDK(policy.Principals)
+	if principals == nil {
+		return []models.InlinePrincipalModel{}
+	}
+	return principals
+}
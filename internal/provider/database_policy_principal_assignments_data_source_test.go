@@ -0,0 +1,86 @@
+// Package provider implements tests for database_policy_principal_assignments_data_source
+package provider
+
+import (
+	"testing"
+
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatabasePolicyPrincipalAssignmentsDataSource_basic tests reading a policy's principals
+// Validates:
+// - The inline principal of the managed policy is returned with its directory
+// - A policy ID that does not exist returns an error instead of empty results
+func TestAccDatabasePolicyPrincipalAssignmentsDataSource_basic(t *testing.T) {
+	config := testAccDatabasePolicyDataSourceConfig("test-ds-principals-policy") + `
+data "cyberarksia_database_policy_principal_assignments" "lookup" {
+  policy_id = cyberarksia_database_policy.lookup.policy_id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_policy_principal_assignments.lookup", "id",
+						"cyberarksia_database_policy.lookup", "policy_id"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_policy_principal_assignments.lookup", "principals.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.cyberarksia_database_policy_principal_assignments.lookup", "principals.*.principal_id",
+						"data.cyberarksia_principal.lookup_user", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.cyberarksia_database_policy_principal_assignments.lookup", "principals.*.source_directory_id",
+						"data.cyberarksia_principal.lookup_user", "directory_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.cyberarksia_database_policy_principal_assignments.lookup", "principals.*",
+						map[string]string{"principal_type": "USER"}),
+				),
+			},
+			{
+				Config: config + `
+data "cyberarksia_database_policy_principal_assignments" "missing" {
+  policy_id = "00000000-0000-4000-8000-000000000000"
+}
+`,
+				ExpectError: mustCompileRegex(`Policy Not Found`),
+			},
+		},
+	})
+}
+
+func TestPrincipalsFromPolicy(t *testing.T) {
+	policy := &uapsiadbmodels.ArkUAPSIADBAccessPolicy{}
+
+	// An unassigned policy is an empty set, not null
+	if got := principalsFromPolicy(policy); got == nil || len(got) != 0 {
+		t.Errorf("principalsFromPolicy() without principals = %#v, want empty non-nil slice", got)
+	}
+
+	policy.Principals = []uapcommonmodels.ArkUAPPrincipal{
+		{
+			ID:                  "user-1",
+			Name:                "alice@example.com",
+			Type:                "USER",
+			SourceDirectoryName: "CyberArk Cloud Directory",
+			SourceDirectoryID:   "dir-1",
+		},
+		{
+			ID:   "role-1",
+			Name: "DBA",
+			Type: "ROLE",
+		},
+	}
+
+	got := principalsFromPolicy(policy)
+	if len(got) != 2 {
+		t.Fatalf("principalsFromPolicy() returned %d principals, want 2", len(got))
+	}
+	if got[0].PrincipalID.ValueString() != "user-1" || got[0].SourceDirectoryID.ValueString() != "dir-1" {
+		t.Errorf("principals[0] = %+v, want user-1 from dir-1", got[0])
+	}
+	if got[1].PrincipalType.ValueString() != "ROLE" || !got[1].SourceDirectoryName.IsNull() || !got[1].SourceDirectoryID.IsNull() {
+		t.Errorf("principals[1] = %+v, want ROLE with null directory", got[1])
+	}
+}
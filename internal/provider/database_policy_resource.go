@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -94,7 +98,7 @@ func (r *DatabasePolicyResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"delegation_classification": schema.StringAttribute{
-				MarkdownDescription: "Delegation classification. Valid values: `restricted`, `unrestricted`. Default: `unrestricted`. **Note**: Currently, SIA only supports `unrestricted` for database policies regardless of the value set. This attribute is available for future compatibility.",
+				MarkdownDescription: "Delegation classification. Valid values: `restricted`, `unrestricted`. Default: `unrestricted`. **Note**: Currently, SIA only supports `unrestricted` for database policies regardless of the value set. This attribute is available for future compatibility. Values are case-insensitive: the API returns `Unrestricted`, and the spelling written in configuration is kept in state.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("unrestricted"),
@@ -120,12 +124,8 @@ func (r *DatabasePolicyResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"last_modified": schema.StringAttribute{
-				MarkdownDescription: "Timestamp of the last modification to the policy.",
-				Optional:            true,
+				MarkdownDescription: "Timestamp of the last modification to the policy (the `updated_on` timestamp, or the creation timestamp if never updated).",
 				Computed:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 			"created_by": schema.SingleNestedAttribute{
 				MarkdownDescription: "Metadata about policy creation (set by API).",
@@ -161,7 +161,8 @@ func (r *DatabasePolicyResource) Schema(ctx context.Context, req resource.Schema
 			"target_database": schema.ListNestedBlock{
 				MarkdownDescription: "Database workspace assignment (repeatable block). **Required**: At least 1 target_database block is required. " +
 					"Follows familiar Terraform patterns (aws_security_group ingress/egress). " +
-					"Use `lifecycle { ignore_changes = [target_database] }` if managing assignments via separate `cyberarksia_policy_database_assignment` resources.",
+					"Use `lifecycle { ignore_changes = [target_database] }` if managing assignments via separate `cyberarksia_policy_database_assignment` resources. " +
+					"`ignore_changes` does not bypass validation, so keep at least one `target_database` block in configuration.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"database_workspace_id": schema.StringAttribute{
@@ -318,11 +319,11 @@ func (r *DatabasePolicyResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "Policy validity period. **Optional**: If not specified, policy never expires (valid indefinitely). When specified, both `from_time` and `to_time` must be provided.",
 				Attributes: map[string]schema.Attribute{
 					"from_time": schema.StringAttribute{
-						MarkdownDescription: "Start time (ISO 8601 format, e.g., `2024-01-01T00:00:00Z`). Required when `time_frame` block is present.",
+						MarkdownDescription: "Start time (RFC3339 format, e.g., `2024-01-01T00:00:00Z`). Required when `time_frame` block is present. Must be before `to_time`.",
 						Optional:            true,
 					},
 					"to_time": schema.StringAttribute{
-						MarkdownDescription: "End time (ISO 8601 format, e.g., `2024-12-31T23:59:59Z`). Required when `time_frame` block is present.",
+						MarkdownDescription: "End time (RFC3339 format, e.g., `2024-12-31T23:59:59Z`). Required when `time_frame` block is present. Must be after `from_time`.",
 						Optional:            true,
 					},
 				},
@@ -361,7 +362,7 @@ func (r *DatabasePolicyResource) Schema(ctx context.Context, req resource.Schema
 								},
 							},
 							"from_hour": schema.StringAttribute{
-								MarkdownDescription: "Start time in HH:MM format (e.g., `09:00`). Optional - if both `from_hour` and `to_hour` are omitted, access is allowed all day. If one is specified, the other must also be specified.",
+								MarkdownDescription: "Start time in HH:MM format (e.g., `09:00`). Optional - if both `from_hour` and `to_hour` are omitted, access is allowed all day. If one is specified, the other must also be specified. Must be earlier than `to_hour`.",
 								Optional:            true,
 								Validators: []validator.String{
 									stringvalidator.RegexMatches(
@@ -371,7 +372,7 @@ func (r *DatabasePolicyResource) Schema(ctx context.Context, req resource.Schema
 								},
 							},
 							"to_hour": schema.StringAttribute{
-								MarkdownDescription: "End time in HH:MM format (e.g., `17:00`). Optional - if both `from_hour` and `to_hour` are omitted, access is allowed all day. If one is specified, the other must also be specified.",
+								MarkdownDescription: "End time in HH:MM format (e.g., `17:00`). Optional - if both `from_hour` and `to_hour` are omitted, access is allowed all day. If one is specified, the other must also be specified. Must be later than `from_hour`; windows cannot span midnight (use `00:00`-`23:59` for the full day).",
 								Optional:            true,
 								Validators: []validator.String{
 									stringvalidator.RegexMatches(
@@ -384,6 +385,7 @@ func (r *DatabasePolicyResource) Schema(ctx context.Context, req resource.Schema
 					},
 				},
 			},
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -509,7 +511,69 @@ func (r *DatabasePolicyResource) ValidateConfig(ctx context.Context, req resourc
 					"When both are omitted, access is allowed all day (00:00-23:59).",
 			)
 		}
+
+		// Windows cannot span midnight; 00:00-23:59 is the full day. Malformed values
+		// are reported by the attribute validators, so they are skipped here
+		if fromHourSet && toHourSet {
+			fromHour := data.Conditions.AccessWindow.FromHour.ValueString()
+			toHour := data.Conditions.AccessWindow.ToHour.ValueString()
+			fromMinutes, fromOK := minutesSinceMidnight(fromHour)
+			toMinutes, toOK := minutesSinceMidnight(toHour)
+
+			if fromOK && toOK && fromMinutes >= toMinutes {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("conditions").AtName("access_window").AtName("to_hour"),
+					"Invalid Access Window Configuration",
+					fmt.Sprintf("from_hour (%s) must be earlier than to_hour (%s). Access windows cannot span midnight; "+
+						"use 00:00-23:59 for the full day.", fromHour, toHour),
+				)
+			}
+		}
+	}
+
+	// Validate time_frame: RFC3339 timestamps with from_time before to_time
+	if data.TimeFrame != nil {
+		fromTime, fromOK := parseTimeFrameValue(data.TimeFrame.FromTime, path.Root("time_frame").AtName("from_time"), &resp.Diagnostics)
+		toTime, toOK := parseTimeFrameValue(data.TimeFrame.ToTime, path.Root("time_frame").AtName("to_time"), &resp.Diagnostics)
+
+		if fromOK && toOK && !fromTime.Before(toTime) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("time_frame").AtName("to_time"),
+				"Invalid Time Frame",
+				fmt.Sprintf("from_time (%s) must be before to_time (%s).",
+					data.TimeFrame.FromTime.ValueString(), data.TimeFrame.ToTime.ValueString()),
+			)
+		}
+	}
+}
+
+// minutesSinceMidnight converts an HH:MM access window hour to minutes since midnight
+// Returns false if the value is not a valid HH:MM time
+func minutesSinceMidnight(hhmm string) (int, bool) {
+	parsed, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return 0, false
+	}
+	return parsed.Hour()*60 + parsed.Minute(), true
+}
+
+// parseTimeFrameValue parses a time_frame timestamp as RFC3339
+// Returns false without a diagnostic for null or unknown values, so ordering is only checked when both are known
+func parseTimeFrameValue(value types.String, attrPath path.Path, diagnostics *diag.Diagnostics) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			attrPath,
+			"Invalid Time Format",
+			fmt.Sprintf("%s must be an RFC3339 timestamp (e.g., 2024-01-01T00:00:00Z), got: %q", attrPath, value.ValueString()),
+		)
+		return time.Time{}, false
 	}
+	return parsed, true
 }
 
 func (r *DatabasePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -521,7 +585,15 @@ func (r *DatabasePolicyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// Convert Terraform state to SDK policy (metadata only)
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Convert Terraform state to SDK policy (metadata, conditions, principals)
 	policy := data.ToSDK()
 
 	// Build inline target databases
@@ -552,7 +624,7 @@ func (r *DatabasePolicyResource) Create(ctx context.Context, req resource.Create
 			workspaceType := "FQDN/IP"
 
 			// Build instance target with authentication profile
-			instanceTarget, buildErr := buildInstanceTarget(ctx, database, targetDB)
+			instanceTarget, buildErr := buildInstanceTarget(ctx, database, targetDB, &resp.Diagnostics)
 			if buildErr != nil {
 				resp.Diagnostics.AddError(
 					"Failed to Build Target",
@@ -567,42 +639,27 @@ func (r *DatabasePolicyResource) Create(ctx context.Context, req resource.Create
 			policy.Targets[workspaceType] = targets
 
 			tflog.Debug(ctx, "Added target database to policy", map[string]interface{}{
-				"database_id":    databaseID,
+				logKeyDatabaseID: databaseID,
 				"workspace_type": workspaceType,
 				"auth_method":    targetDB.AuthenticationMethod.ValueString(),
 			})
 		}
 	}
 
-	// Build inline principals
-	if len(data.Principal) > 0 {
-		policy.Principals = make([]uapcommonmodels.ArkUAPPrincipal, len(data.Principal))
-		for i, principal := range data.Principal {
-			policy.Principals[i] = uapcommonmodels.ArkUAPPrincipal{
-				ID:                  principal.PrincipalID.ValueString(),
-				Name:                principal.PrincipalName.ValueString(),
-				Type:                principal.PrincipalType.ValueString(),
-				SourceDirectoryName: principal.SourceDirectoryName.ValueString(),
-				SourceDirectoryID:   principal.SourceDirectoryID.ValueString(),
-			}
-
-			tflog.Info(ctx, "SENDING PRINCIPAL TO API", map[string]interface{}{
-				"principal_id":          principal.PrincipalID.ValueString(),
-				"principal_name":        principal.PrincipalName.ValueString(),
-				"principal_type":        principal.PrincipalType.ValueString(),
-				"source_directory_name": principal.SourceDirectoryName.ValueString(),
-				"source_directory_id":   principal.SourceDirectoryID.ValueString(),
-			})
-		}
+	// Inline principals are converted by ToSDK()
+	for _, principal := range data.Principal {
+		tflog.Info(ctx, "SENDING PRINCIPAL TO API", map[string]interface{}{
+			logKeyPrincipalID:       principal.PrincipalID.ValueString(),
+			"principal_name":        principal.PrincipalName.ValueString(),
+			"principal_type":        principal.PrincipalType.ValueString(),
+			"source_directory_name": principal.SourceDirectoryName.ValueString(),
+			"source_directory_id":   principal.SourceDirectoryID.ValueString(),
+		})
 	}
 
 	// Create policy with retry logic
 	var createdPolicy *uapsiadbmodels.ArkUAPSIADBAccessPolicy
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		var createErr error
 		createdPolicy, createErr = r.providerData.UAPClient.Db().AddPolicy(policy)
 		return createErr
@@ -619,9 +676,9 @@ func (r *DatabasePolicyResource) Create(ctx context.Context, req resource.Create
 	})
 	for i, p := range createdPolicy.Principals {
 		tflog.Info(ctx, fmt.Sprintf("API RETURNED PRINCIPAL %d", i), map[string]interface{}{
-			"id":   p.ID,
-			"name": p.Name,
-			"type": p.Type,
+			logKeyPrincipalID: p.ID,
+			"name":            p.Name,
+			"type":            p.Type,
 		})
 	}
 
@@ -632,8 +689,8 @@ func (r *DatabasePolicyResource) Create(ctx context.Context, req resource.Create
 	data.ID = types.StringValue(createdPolicy.Metadata.PolicyID)
 	data.PolicyID = types.StringValue(createdPolicy.Metadata.PolicyID)
 
-	// Set last_modified to empty string (API doesn't return this field on create)
-	data.LastModified = types.StringValue("")
+	// Null when the create response carries no timestamps; filled in by the Read after Create
+	data.LastModified = models.LastModifiedFromSDK(createdPolicy.Metadata)
 
 	// Explicitly set computed metadata fields to null to avoid "unknown value" errors
 	// These will be populated by the automatic Read() call after Create()
@@ -641,7 +698,7 @@ func (r *DatabasePolicyResource) Create(ctx context.Context, req resource.Create
 	data.UpdatedOn = types.ObjectNull(models.ChangeInfoAttrTypes())
 
 	tflog.Info(ctx, "Created database policy", map[string]interface{}{
-		"policy_id":        data.PolicyID.ValueString(),
+		logKeyPolicyID:     data.PolicyID.ValueString(),
 		"policy_name":      data.Name.ValueString(),
 		"target_databases": len(data.TargetDatabase),
 		"principals":       len(data.Principal),
@@ -660,6 +717,14 @@ func (r *DatabasePolicyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	policyID := data.PolicyID.ValueString()
 
 	// Fetch policy from API
@@ -686,9 +751,13 @@ func (r *DatabasePolicyResource) Read(ctx context.Context, req resource.ReadRequ
 		)
 		return
 	}
+	data.TargetDatabase = inlineTargetsFromPolicy(ctx, policy, data.TargetDatabase, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Read database policy", map[string]interface{}{
-		"policy_id": data.PolicyID.ValueString(),
+		logKeyPolicyID: data.PolicyID.ValueString(),
 	})
 
 	// Save updated data into Terraform state
@@ -704,9 +773,17 @@ func (r *DatabasePolicyResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	policyID := data.PolicyID.ValueString()
 
-	// Convert new state to SDK (metadata only)
+	// Convert new state to SDK (metadata, conditions, principals)
 	updatedPolicy := data.ToSDK()
 
 	// Build inline target databases (if provided)
@@ -737,7 +814,7 @@ func (r *DatabasePolicyResource) Update(ctx context.Context, req resource.Update
 			workspaceType := "FQDN/IP"
 
 			// Build instance target with authentication profile
-			instanceTarget, buildErr := buildInstanceTarget(ctx, database, targetDB)
+			instanceTarget, buildErr := buildInstanceTarget(ctx, database, targetDB, &resp.Diagnostics)
 			if buildErr != nil {
 				resp.Diagnostics.AddError(
 					"Failed to Build Target",
@@ -752,38 +829,23 @@ func (r *DatabasePolicyResource) Update(ctx context.Context, req resource.Update
 			updatedPolicy.Targets[workspaceType] = targets
 
 			tflog.Debug(ctx, "Updated target database in policy", map[string]interface{}{
-				"database_id":    databaseID,
+				logKeyDatabaseID: databaseID,
 				"workspace_type": workspaceType,
 				"auth_method":    targetDB.AuthenticationMethod.ValueString(),
 			})
 		}
 	}
 
-	// Build inline principals (if provided)
-	if len(data.Principal) > 0 {
-		updatedPolicy.Principals = make([]uapcommonmodels.ArkUAPPrincipal, len(data.Principal))
-		for i, principal := range data.Principal {
-			updatedPolicy.Principals[i] = uapcommonmodels.ArkUAPPrincipal{
-				ID:                  principal.PrincipalID.ValueString(),
-				Name:                principal.PrincipalName.ValueString(),
-				Type:                principal.PrincipalType.ValueString(),
-				SourceDirectoryName: principal.SourceDirectoryName.ValueString(),
-				SourceDirectoryID:   principal.SourceDirectoryID.ValueString(),
-			}
-
-			tflog.Debug(ctx, "Updated principal in policy", map[string]interface{}{
-				"principal_id":   principal.PrincipalID.ValueString(),
-				"principal_type": principal.PrincipalType.ValueString(),
-			})
-		}
+	// Inline principals are converted by ToSDK()
+	for _, principal := range data.Principal {
+		tflog.Debug(ctx, "Updated principal in policy", map[string]interface{}{
+			logKeyPrincipalID: principal.PrincipalID.ValueString(),
+			"principal_type":  principal.PrincipalType.ValueString(),
+		})
 	}
 
 	// Update policy with retry logic
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		_, err := r.providerData.UAPClient.Db().UpdatePolicy(updatedPolicy)
 		return err
 	})
@@ -803,7 +865,10 @@ func (r *DatabasePolicyResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// Update state with refreshed policy
+	// Update state with refreshed policy. As in Create, the principal and target_database
+	// blocks keep their planned values: the API may reorder or respell them, and state for
+	// configured blocks must match the plan. Read reconciles them by ID on refresh
+	plannedPrincipals := data.Principal
 	if err := data.FromSDK(ctx, refreshedPolicy); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Converting Policy Response",
@@ -811,9 +876,10 @@ func (r *DatabasePolicyResource) Update(ctx context.Context, req resource.Update
 		)
 		return
 	}
+	data.Principal = plannedPrincipals
 
 	tflog.Info(ctx, "Updated database policy", map[string]interface{}{
-		"policy_id":        data.PolicyID.ValueString(),
+		logKeyPolicyID:     data.PolicyID.ValueString(),
 		"target_databases": len(data.TargetDatabase),
 		"principals":       len(data.Principal),
 	})
@@ -831,15 +897,19 @@ func (r *DatabasePolicyResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	policyID := data.PolicyID.ValueString()
 
 	// Delete policy with retry logic using workaround (ARK SDK v1.5.0 bug)
 	// Note: API automatically cascades deletion to principals and targets
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		return client.DeleteDatabasePolicyDirect(ctx, r.providerData.AuthContext, policyID)
 	})
 
@@ -847,7 +917,7 @@ func (r *DatabasePolicyResource) Delete(ctx context.Context, req resource.Delete
 		// If already deleted, treat as success
 		if client.IsNotFoundError(err) {
 			tflog.Info(ctx, "Policy already deleted", map[string]interface{}{
-				"policy_id": policyID,
+				logKeyPolicyID: policyID,
 			})
 			return
 		}
@@ -857,7 +927,7 @@ func (r *DatabasePolicyResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	tflog.Info(ctx, "Deleted database policy", map[string]interface{}{
-		"policy_id": policyID,
+		logKeyPolicyID: policyID,
 	})
 }
 
@@ -884,10 +954,15 @@ func (r *DatabasePolicyResource) ImportState(ctx context.Context, req resource.I
 		)
 		return
 	}
+	data.TargetDatabase = inlineTargetsFromPolicy(ctx, policy, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Timeouts = nullTimeouts()
 
 	tflog.Info(ctx, "Imported database policy", map[string]interface{}{
-		"policy_id":   data.PolicyID.ValueString(),
-		"policy_name": data.Name.ValueString(),
+		logKeyPolicyID: data.PolicyID.ValueString(),
+		"policy_name":  data.Name.ValueString(),
 	})
 
 	// Save imported state
@@ -904,8 +979,10 @@ func mustCompileRegex(pattern string) *regexp.Regexp {
 }
 
 // buildInstanceTarget creates an ArkUAPSIADBInstanceTarget from database workspace and assignment data
-// This function handles all 6 authentication methods and their corresponding profiles
-func buildInstanceTarget(ctx context.Context, database *dbmodels.ArkSIADBDatabase, targetDB models.InlineDatabaseAssignmentModel) (*uapsiadbmodels.ArkUAPSIADBInstanceTarget, error) {
+// This function handles all 6 authentication methods and their corresponding profiles. An unknown
+// method adds a warning to diagnostics and builds a target without a profile, so methods added to
+// SIA after this provider version are passed through rather than blocking the policy
+func buildInstanceTarget(ctx context.Context, database *dbmodels.ArkSIADBDatabase, targetDB models.InlineDatabaseAssignmentModel, diagnostics *diag.Diagnostics) (*uapsiadbmodels.ArkUAPSIADBInstanceTarget, error) {
 	authMethod := targetDB.AuthenticationMethod.ValueString()
 
 	instanceTarget := &uapsiadbmodels.ArkUAPSIADBInstanceTarget{
@@ -1020,8 +1097,87 @@ func buildInstanceTarget(ctx context.Context, database *dbmodels.ArkSIADBDatabas
 		}
 
 	default:
-		return nil, fmt.Errorf("unsupported authentication method: %s", authMethod)
+		tflog.Warn(ctx, "Unrecognized authentication method, building target without a profile", map[string]interface{}{
+			logKeyDatabaseID: instanceTarget.InstanceID,
+			"auth_method":    authMethod,
+		})
+		diagnostics.AddWarning(
+			"Unrecognized Authentication Method",
+			fmt.Sprintf("Authentication method %q for database workspace %s is not known to this provider version. "+
+				"The target is sent without an authentication profile; upgrade the provider if SIA rejects it.",
+				authMethod, instanceTarget.InstanceID),
+		)
 	}
 
 	return instanceTarget, nil
 }
+
+// inlineTargetsFromPolicy converts policy instance targets to inline target_database blocks,
+// ordered like prior (see orderTargetsLike). This is the inverse of buildInstanceTarget;
+// profile parsing is shared with the policy_database_assignment resource via ParseAuthenticationProfile
+func inlineTargetsFromPolicy(ctx context.Context, policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy, prior []models.InlineDatabaseAssignmentModel, diagnostics *diag.Diagnostics) []models.InlineDatabaseAssignmentModel {
+	// Sort workspace types for deterministic ordering (map iteration is random)
+	workspaceTypes := make([]string, 0, len(policy.Targets))
+	for workspaceType := range policy.Targets {
+		workspaceTypes = append(workspaceTypes, workspaceType)
+	}
+	sort.Strings(workspaceTypes)
+
+	var targets []models.InlineDatabaseAssignmentModel
+	for _, workspaceType := range workspaceTypes {
+		for i := range policy.Targets[workspaceType].Instances {
+			instance := &policy.Targets[workspaceType].Instances[i]
+
+			var profile models.DatabasePolicyDatabaseAssignmentModel
+			ParseAuthenticationProfile(ctx, instance, &profile, diagnostics)
+			if diagnostics.HasError() {
+				return nil
+			}
+
+			targets = append(targets, models.InlineDatabaseAssignmentModel{
+				DatabaseWorkspaceID:   types.StringValue(instance.InstanceID),
+				AuthenticationMethod:  types.StringValue(instance.AuthenticationMethod),
+				DBAuthProfile:         profile.DBAuthProfile,
+				LDAPAuthProfile:       profile.LDAPAuthProfile,
+				OracleAuthProfile:     profile.OracleAuthProfile,
+				MongoAuthProfile:      profile.MongoAuthProfile,
+				SQLServerAuthProfile:  profile.SQLServerAuthProfile,
+				RDSIAMUserAuthProfile: profile.RDSIAMUserAuthProfile,
+			})
+		}
+	}
+
+	return orderTargetsLike(prior, targets)
+}
+
+// orderTargetsLike orders targets like the prior target_database blocks. target_database is a
+// list block, so the API's grouping by workspace type would otherwise show as a diff. Targets
+// are matched by database_workspace_id; matched targets keep prior order, targets missing from
+// prior are appended in API order, and prior targets missing from the API are dropped
+func orderTargetsLike(prior, targets []models.InlineDatabaseAssignmentModel) []models.InlineDatabaseAssignmentModel {
+	if len(prior) == 0 || len(targets) == 0 {
+		return targets
+	}
+
+	remaining := make(map[string]int, len(targets))
+	for i, target := range targets {
+		remaining[target.DatabaseWorkspaceID.ValueString()] = i
+	}
+
+	ordered := make([]models.InlineDatabaseAssignmentModel, 0, len(targets))
+	for _, target := range prior {
+		workspaceID := target.DatabaseWorkspaceID.ValueString()
+		if i, ok := remaining[workspaceID]; ok {
+			ordered = append(ordered, targets[i])
+			delete(remaining, workspaceID)
+		}
+	}
+
+	for _, target := range targets {
+		if _, ok := remaining[target.DatabaseWorkspaceID.ValueString()]; ok {
+			ordered = append(ordered, target)
+		}
+	}
+
+	return ordered
+}
@@ -0,0 +1,498 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	dbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/sia/workspaces/db/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
+)
+
+// Test authentication_method resolution from API responses
+func TestAuthenticationMethodFromAPI(t *testing.T) {
+	tests := []struct {
+		name     string
+		database *dbmodels.ArkSIADBDatabase
+		current  types.String
+		want     types.String
+	}{
+		{
+			name:     "API value set",
+			database: newTestDatabaseWithAuthMethod("ad_ephemeral_user"),
+			current:  types.StringValue("local_ephemeral_user"),
+			want:     types.StringValue("ad_ephemeral_user"),
+		},
+		{
+			name:     "API value set with null state (import)",
+			database: newTestDatabaseWithAuthMethod("local_ephemeral_user"),
+			current:  types.StringNull(),
+			want:     types.StringValue("local_ephemeral_user"),
+		},
+		{
+			name:     "API value missing preserves state",
+			database: &dbmodels.ArkSIADBDatabase{ID: 42, Name: "legacy-db"},
+			current:  types.StringValue("local_ephemeral_user"),
+			want:     types.StringValue("local_ephemeral_user"),
+		},
+		{
+			name:     "API value missing with null state",
+			database: &dbmodels.ArkSIADBDatabase{ID: 42, Name: "legacy-db"},
+			current:  types.StringNull(),
+			want:     types.StringNull(),
+		},
+		{
+			name:     "API value missing with empty state",
+			database: &dbmodels.ArkSIADBDatabase{ID: 42, Name: "legacy-db"},
+			current:  types.StringValue(""),
+			want:     types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := authenticationMethodFromAPI(tt.database, tt.current)
+			if !got.Equal(tt.want) {
+				t.Errorf("authenticationMethodFromAPI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test services resolution from API responses, including nil and empty lists
+func TestServicesFromAPI(t *testing.T) {
+	emptyList := types.ListValueMust(types.StringType, []attr.Value{})
+	staleList := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ORCL")})
+
+	tests := []struct {
+		name     string
+		services []string
+		current  types.List
+		want     types.List
+	}{
+		{
+			name:     "API services set",
+			services: []string{"ORCL", "ORCLPDB1"},
+			current:  types.ListNull(types.StringType),
+			want: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("ORCL"),
+				types.StringValue("ORCLPDB1"),
+			}),
+		},
+		{
+			name:     "API empty list clears stale state",
+			services: []string{},
+			current:  staleList,
+			want:     emptyList,
+		},
+		{
+			name:     "API nil list clears stale state",
+			services: nil,
+			current:  staleList,
+			want:     emptyList,
+		},
+		{
+			name:     "API empty list with empty state",
+			services: []string{},
+			current:  emptyList,
+			want:     emptyList,
+		},
+		{
+			name:     "API empty list with null state",
+			services: []string{},
+			current:  types.ListNull(types.StringType),
+			want:     types.ListNull(types.StringType),
+		},
+		{
+			name:     "API nil list with null state (import)",
+			services: nil,
+			current:  types.ListNull(types.StringType),
+			want:     types.ListNull(types.StringType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := servicesFromAPI(context.Background(), tt.services, tt.current)
+			if diags.HasError() {
+				t.Fatalf("servicesFromAPI() diagnostics: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("servicesFromAPI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test tags resolution from API responses, distinguishing nil, empty, and populated maps
+func TestTagsFromAPI(t *testing.T) {
+	emptyMap := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	staleMap := types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dev")})
+
+	tests := []struct {
+		name    string
+		tags    map[string]string
+		current types.Map
+		want    types.Map
+	}{
+		{
+			name:    "API tags set",
+			tags:    map[string]string{"env": "prod", "team": "dba"},
+			current: types.MapNull(types.StringType),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":  types.StringValue("prod"),
+				"team": types.StringValue("dba"),
+			}),
+		},
+		{
+			name:    "API tags replace stale state",
+			tags:    map[string]string{"env": "prod"},
+			current: staleMap,
+			want:    types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+		},
+		{
+			name:    "API nil map with null state",
+			tags:    nil,
+			current: types.MapNull(types.StringType),
+			want:    types.MapNull(types.StringType),
+		},
+		{
+			name:    "API empty map with null state",
+			tags:    map[string]string{},
+			current: types.MapNull(types.StringType),
+			want:    types.MapNull(types.StringType),
+		},
+		{
+			name:    "API empty map with empty state",
+			tags:    map[string]string{},
+			current: emptyMap,
+			want:    emptyMap,
+		},
+		{
+			name:    "API nil map clears stale state",
+			tags:    nil,
+			current: staleMap,
+			want:    emptyMap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tagsFromAPI(context.Background(), tt.tags, tt.current)
+			if diags.HasError() {
+				t.Fatalf("tagsFromAPI() diagnostics: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("tagsFromAPI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestDatabaseWithAuthMethod builds an API response with the given configured auth method type
+func newTestDatabaseWithAuthMethod(authMethod string) *dbmodels.ArkSIADBDatabase {
+	return &dbmodels.ArkSIADBDatabase{
+		ID:   42,
+		Name: "test-db",
+		ConfiguredAuthMethod: dbmodels.ArkSIADBDatabaseTargetConfiguredAuthMethod{
+			DatabaseAuthMethod: dbmodels.ArkSIADBDatabaseAuthMethod{
+				AuthMethod: dbmodels.ArkSIADBAuthMethod{AuthMethodType: authMethod},
+			},
+		},
+	}
+}
+
+// Test that SQL Server engine aliases resolve to the MSSQL family used as the policy InstanceType.
+// InstanceType is taken from the workspace's ProviderDetails.Family, which SIA derives from the
+// SDK engine-to-family mapping - a regression here would silently mis-assign policy targets.
+func TestDatabaseEngineFamily_SQLServerAliases(t *testing.T) {
+	engines := []string{
+		"sqlserver",
+		"mssql",
+		"mssql-azure-managed",
+		"sqlserver-sh",
+		"mssql-aws-rds",
+	}
+
+	for _, engine := range engines {
+		t.Run(engine, func(t *testing.T) {
+			family, ok := dbmodels.DatabasesEnginesToFamily[engine]
+			if !ok {
+				t.Fatalf("engine %q has no family mapping", engine)
+			}
+			if family != dbmodels.FamilyTypeMSSQL {
+				t.Errorf("engine %q family = %q, want %q", engine, family, dbmodels.FamilyTypeMSSQL)
+			}
+		})
+	}
+}
+
+// Test certificate error detection across the API error phrasings we have seen or expect
+func TestHandleCertificateError(t *testing.T) {
+	const certID = "1234567890123456"
+
+	tests := []struct {
+		name          string
+		certificateID types.String
+		err           error
+		want          bool
+	}{
+		{
+			name:          "not found",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("certificate not found"),
+			want:          true,
+		},
+		{
+			name:          "not found uppercase",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("CERTIFICATE NOT FOUND"),
+			want:          true,
+		},
+		{
+			name:          "does not exist",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("Certificate 1234567890123456 does not exist"),
+			want:          true,
+		},
+		{
+			name:          "invalid certificate",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("invalid certificate"),
+			want:          true,
+		},
+		{
+			name:          "invalid certificate ID in API error",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("failed to add database - [400] - [{\"code\":\"BAD_REQUEST\",\"description\":\"certificate id is invalid\"}]"),
+			want:          true,
+		},
+		{
+			name:          "wrapped not found",
+			certificateID: types.StringValue(certID),
+			err:           fmt.Errorf("add database: %w", errors.New("the certificate was not found")),
+			want:          true,
+		},
+		{
+			name:          "abbreviated cert",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("cert does not exist"),
+			want:          false,
+		},
+		{
+			name:          "database not found",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("database not found"),
+			want:          false,
+		},
+		{
+			name:          "invalid secret",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("invalid secret_id"),
+			want:          false,
+		},
+		{
+			name:          "certificate expired",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("certificate has expired"),
+			want:          false,
+		},
+		{
+			name:          "certificate in use",
+			certificateID: types.StringValue(certID),
+			err:           errors.New("certificate is already in use"),
+			want:          false,
+		},
+		{
+			name:          "no certificate configured",
+			certificateID: types.StringNull(),
+			err:           errors.New("certificate not found"),
+			want:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.CreateResponse{}
+
+			got := handleCertificateError(tt.certificateID, tt.err, resp)
+			if got != tt.want {
+				t.Fatalf("handleCertificateError() = %v, want %v", got, tt.want)
+			}
+
+			if !tt.want {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("expected no diagnostics, got %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected 1 error diagnostic, got %d", resp.Diagnostics.ErrorsCount())
+			}
+			d := resp.Diagnostics.Errors()[0]
+			if d.Summary() != "Certificate Not Found" {
+				t.Errorf("summary = %q, want %q", d.Summary(), "Certificate Not Found")
+			}
+			if !strings.Contains(d.Detail(), certID) {
+				t.Errorf("detail missing certificate ID %q: %s", certID, d.Detail())
+			}
+			if !strings.Contains(d.Detail(), tt.err.Error()) {
+				t.Errorf("detail missing original error %q: %s", tt.err.Error(), d.Detail())
+			}
+		})
+	}
+}
+
+// Test that handleCertificateError populates diagnostics on Update and ignores other response types
+func TestHandleCertificateError_ResponseTypes(t *testing.T) {
+	certID := types.StringValue("1234567890123456")
+	err := errors.New("certificate not found")
+
+	updateResp := &resource.UpdateResponse{}
+	if !handleCertificateError(certID, err, updateResp) {
+		t.Fatal("expected UpdateResponse to be handled")
+	}
+	if !updateResp.Diagnostics.HasError() {
+		t.Error("expected error diagnostic on UpdateResponse")
+	}
+
+	readResp := &resource.ReadResponse{}
+	if handleCertificateError(certID, err, readResp) {
+		t.Error("expected ReadResponse to be ignored")
+	}
+	if readResp.Diagnostics.HasError() {
+		t.Error("expected no diagnostics on ReadResponse")
+	}
+}
+
+// Test enable_certificate_validation defaults to true in the plan, so an
+// omitted attribute matches the true read back from the API (no diff)
+func TestDatabaseWorkspaceResource_enableCertificateValidationDefault(t *testing.T) {
+	ctx := context.Background()
+	r := &databaseWorkspaceResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	attribute, ok := schemaResp.Schema.Attributes["enable_certificate_validation"].(schema.BoolAttribute)
+	if !ok {
+		t.Fatalf("enable_certificate_validation is %T, want schema.BoolAttribute", schemaResp.Schema.Attributes["enable_certificate_validation"])
+	}
+	if !attribute.Optional || !attribute.Computed {
+		t.Errorf("Optional/Computed = %v/%v, want true/true", attribute.Optional, attribute.Computed)
+	}
+	if attribute.Default == nil {
+		t.Fatal("Default is nil, want true")
+	}
+
+	var defaultResp defaults.BoolResponse
+	attribute.Default.DefaultBool(ctx, defaults.BoolRequest{}, &defaultResp)
+	if defaultResp.Diagnostics.HasError() {
+		t.Fatalf("DefaultBool() diagnostics: %v", defaultResp.Diagnostics)
+	}
+	if !defaultResp.PlanValue.Equal(types.BoolValue(true)) {
+		t.Errorf("default = %s, want true", defaultResp.PlanValue)
+	}
+}
+
+// Test authentication_method is computed and keeps the state value when omitted, so
+// the family default SIA reads back does not show as a change to null on every plan
+func TestDatabaseWorkspaceResource_authenticationMethodOmitted(t *testing.T) {
+	ctx := context.Background()
+	r := &databaseWorkspaceResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	attribute, ok := schemaResp.Schema.Attributes["authentication_method"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("authentication_method is %T, want schema.StringAttribute", schemaResp.Schema.Attributes["authentication_method"])
+	}
+	if !attribute.Optional || !attribute.Computed {
+		t.Errorf("Optional/Computed = %v/%v, want true/true", attribute.Optional, attribute.Computed)
+	}
+
+	req := planmodifier.StringRequest{
+		State:       tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})},
+		StateValue:  types.StringValue("local_ephemeral_user"),
+		ConfigValue: types.StringNull(),
+		PlanValue:   types.StringUnknown(),
+	}
+	resp := planmodifier.StringResponse{PlanValue: req.PlanValue}
+	for _, modifier := range attribute.PlanModifiers {
+		modifier.PlanModifyString(ctx, req, &resp)
+	}
+	if !resp.PlanValue.Equal(req.StateValue) {
+		t.Errorf("planned authentication_method = %s, want the state value %s", resp.PlanValue, req.StateValue)
+	}
+}
+
+// Test optional string fields read back as null when the API returns ""
+func TestOptionalStringsFromAPI(t *testing.T) {
+	tests := []struct {
+		field string
+		set   func(*dbmodels.ArkSIADBDatabase, string)
+		get   func(*models.DatabaseWorkspaceModel) types.String
+	}{
+		{
+			field: "read_only_endpoint",
+			set:   func(d *dbmodels.ArkSIADBDatabase, v string) { d.ReadOnlyEndpoint = v },
+			get:   func(m *models.DatabaseWorkspaceModel) types.String { return m.ReadOnlyEndpoint },
+		},
+		{
+			field: "network_name",
+			set:   func(d *dbmodels.ArkSIADBDatabase, v string) { d.NetworkName = v },
+			get:   func(m *models.DatabaseWorkspaceModel) types.String { return m.NetworkName },
+		},
+		{
+			field: "auth_database",
+			set:   func(d *dbmodels.ArkSIADBDatabase, v string) { d.AuthDatabase = v },
+			get:   func(m *models.DatabaseWorkspaceModel) types.String { return m.AuthDatabase },
+		},
+		{
+			field: "account",
+			set:   func(d *dbmodels.ArkSIADBDatabase, v string) { d.Account = v },
+			get:   func(m *models.DatabaseWorkspaceModel) types.String { return m.Account },
+		},
+		{
+			field: "region",
+			set:   func(d *dbmodels.ArkSIADBDatabase, v string) { d.Region = v },
+			get:   func(m *models.DatabaseWorkspaceModel) types.String { return m.Region },
+		},
+		{
+			field: "certificate_id",
+			set:   func(d *dbmodels.ArkSIADBDatabase, v string) { d.Certificate = v },
+			get:   func(m *models.DatabaseWorkspaceModel) types.String { return m.CertificateID },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			database := &dbmodels.ArkSIADBDatabase{}
+			var state models.DatabaseWorkspaceModel
+
+			tt.set(database, "value-from-api")
+			optionalStringsFromAPI(database, &state)
+			if got := tt.get(&state); !got.Equal(types.StringValue("value-from-api")) {
+				t.Errorf("%s = %s, want \"value-from-api\"", tt.field, got)
+			}
+
+			// Removed outside Terraform: the API returns "" and state must become null
+			tt.set(database, "")
+			optionalStringsFromAPI(database, &state)
+			if got := tt.get(&state); !got.IsNull() {
+				t.Errorf("%s = %s after API returned \"\", want null", tt.field, got)
+			}
+		})
+	}
+}
@@ -11,12 +11,17 @@ import (
 	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
 	"github.com/aaearon/terraform-provider-cyberark-sia/internal/validators"
 	dbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/sia/workspaces/db/models"
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
+	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -29,6 +34,7 @@ var (
 	_ resource.Resource                = &databaseWorkspaceResource{}
 	_ resource.ResourceWithConfigure   = &databaseWorkspaceResource{}
 	_ resource.ResourceWithImportState = &databaseWorkspaceResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseWorkspaceResource{}
 )
 
 // cloudProviderToAPI converts user-friendly cloud_provider values to API-expected Platform values
@@ -54,6 +60,65 @@ func cloudProviderFromAPI(apiValue string) string {
 	}
 }
 
+// authenticationMethodFromAPI resolves authentication_method from the API response
+// Legacy workspaces may have no configured auth method type set; in that case the prior
+// state value is preserved (or null if none) to avoid a spurious diff against config
+func authenticationMethodFromAPI(database *dbmodels.ArkSIADBDatabase, current types.String) types.String {
+	if authMethod := database.ConfiguredAuthMethod.DatabaseAuthMethod.AuthMethod.AuthMethodType; authMethod != "" {
+		return types.StringValue(authMethod)
+	}
+	if !current.IsNull() && !current.IsUnknown() && current.ValueString() != "" {
+		return current
+	}
+	return types.StringNull()
+}
+
+// optionalStringFromAPI maps an optional API string to state
+// The API reports unset fields as "", which must be null to match an omitted attribute
+func optionalStringFromAPI(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// optionalStringsFromAPI sets the optional string attributes of state from the API response,
+// so a value removed outside Terraform (e.g. a dropped read replica) reads back as null
+func optionalStringsFromAPI(database *dbmodels.ArkSIADBDatabase, state *models.DatabaseWorkspaceModel) {
+	state.ReadOnlyEndpoint = optionalStringFromAPI(database.ReadOnlyEndpoint)
+	state.NetworkName = optionalStringFromAPI(database.NetworkName)
+	state.AuthDatabase = optionalStringFromAPI(database.AuthDatabase)
+	state.Account = optionalStringFromAPI(database.Account)
+	state.Region = optionalStringFromAPI(database.Region)
+	state.CertificateID = optionalStringFromAPI(database.Certificate)
+}
+
+// servicesFromAPI converts the API services list to state
+// An empty API list clears any prior services: it becomes an empty list when services were
+// tracked in state (detecting out-of-band removal) and null when they never were
+func servicesFromAPI(ctx context.Context, services []string, current types.List) (types.List, diag.Diagnostics) {
+	if len(services) > 0 {
+		return types.ListValueFrom(ctx, types.StringType, services)
+	}
+	if !current.IsNull() && !current.IsUnknown() {
+		return types.ListValueMust(types.StringType, []attr.Value{}), nil
+	}
+	return types.ListNull(types.StringType), nil
+}
+
+// tagsFromAPI converts the API tags map to state
+// Nil and empty API maps are treated alike: an empty map when tags were tracked in state
+// (so a configured `tags = {}` does not diff) and null otherwise
+func tagsFromAPI(ctx context.Context, tags map[string]string, current types.Map) (types.Map, diag.Diagnostics) {
+	if len(tags) > 0 {
+		return types.MapValueFrom(ctx, types.StringType, tags)
+	}
+	if !current.IsNull() && !current.IsUnknown() {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{}), nil
+	}
+	return types.MapNull(types.StringType), nil
+}
+
 // NewDatabaseWorkspaceResource is a helper function to simplify the provider implementation
 func NewDatabaseWorkspaceResource() resource.Resource {
 	return &databaseWorkspaceResource{}
@@ -121,8 +186,13 @@ func (r *databaseWorkspaceResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"port": schema.Int64Attribute{
-				Description: "TCP port for database connections (1-65535). Optional - SDK uses database family defaults if not provided.",
-				Optional:    true,
+				Description: "TCP port for database connections (1-65535). Optional - SIA uses the database family default (e.g., 5432 for postgres) if not provided, " +
+					"and the applied port is stored in state.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.Int64{
 					int64validator.Between(1, 65535),
 				},
@@ -152,11 +222,15 @@ func (r *databaseWorkspaceResource) Schema(ctx context.Context, req resource.Sch
 			},
 			"network_name": schema.StringAttribute{
 				Description: "Network name where the database resides (NetworkName in SDK). " +
-					"Used for network segmentation and isolation. Defaults to 'ON-PREMISE' if not specified.",
+					"Used for network segmentation and isolation. Defaults to 'ON-PREMISE' if not specified. " +
+					"Changing a configured value forces replacement, and the plan warns when access policies target the workspace.",
 				Optional: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(255),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"read_only_endpoint": schema.StringAttribute{
 				Description: "Read-only endpoint for the database (ReadOnlyEndpoint in SDK). " +
@@ -168,9 +242,13 @@ func (r *databaseWorkspaceResource) Schema(ctx context.Context, req resource.Sch
 			},
 			"authentication_method": schema.StringAttribute{
 				Description: "How SIA authenticates to the database (ConfiguredAuthMethodType in SDK). " +
-					"Optional - SDK uses database family defaults if not provided. " +
+					"Optional - SDK uses database family defaults if not provided, and the default chosen by SIA is read back into state. " +
 					"Valid values: ad_ephemeral_user, local_ephemeral_user, rds_iam_authentication, atlas_ephemeral_user",
 				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf("ad_ephemeral_user", "local_ephemeral_user", "rds_iam_authentication", "atlas_ephemeral_user"),
 				},
@@ -190,15 +268,17 @@ func (r *databaseWorkspaceResource) Schema(ctx context.Context, req resource.Sch
 					"When true, requires valid TLS certificates. Defaults to true for security. " +
 					"Set to false only if using self-signed certificates in non-production environments.",
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
 			},
 			"certificate_id": schema.StringAttribute{
 				Description: "Certificate ID for TLS/mTLS connections (Certificate in SDK). " +
 					"References a certificate stored in SIA's certificate service. " +
 					"Optional - used for mutual TLS (mTLS) or custom CA certificates. " +
-					"References cyberark_sia_certificate resource ID.",
+					"References cyberark_sia_certificate resource ID (16-digit numeric string).",
 				Optional: true,
 				Validators: []validator.String{
-					stringvalidator.LengthAtLeast(1),
+					validators.SIACertificateID(),
 				},
 			},
 			"cloud_provider": schema.StringAttribute{
@@ -235,6 +315,10 @@ func (r *databaseWorkspaceResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -258,6 +342,103 @@ func (r *databaseWorkspaceResource) Configure(ctx context.Context, req resource.
 	r.providerData = providerData
 }
 
+// ModifyPlan warns when a network_name change replaces a workspace that access policies
+// still target. The replacement gets a new ID, so those policies no longer grant access
+// to the database until their targets are updated.
+func (r *databaseWorkspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan models.DatabaseWorkspaceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Mirrors RequiresReplaceIfConfigured: only a configured, changed value replaces
+	if plan.NetworkName.IsNull() || plan.NetworkName.IsUnknown() || plan.NetworkName.Equal(state.NetworkName) {
+		return
+	}
+	if r.providerData == nil || r.providerData.UAPClient == nil {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	policyIDs, err := r.policiesTargetingDatabase(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("network_name"),
+			"Unable to Check Policy References",
+			fmt.Sprintf("Changing network_name replaces database workspace %s, but the access policies targeting it "+
+				"could not be listed: %s. Policies that target the current workspace ID must be updated after the replacement.",
+				state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	if len(policyIDs) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("network_name"),
+		"Database Workspace Targeted by Access Policies",
+		fmt.Sprintf("Changing network_name to %q replaces database workspace %s, which is targeted by %d access "+
+			"policies (IDs: %s). The replacement gets a new ID, so these policies no longer grant access to the database "+
+			"until their targets reference the new workspace. Policies managed in this configuration through "+
+			"cyberarksia_database_workspace references are updated by the same apply; others must be updated manually.",
+			plan.NetworkName.ValueString(), state.ID.ValueString(), len(policyIDs), strings.Join(policyIDs, ", ")),
+	)
+}
+
+// policiesTargetingDatabase returns the IDs of the access policies with databaseID as a target.
+// The list response does not carry targets, so every policy is read by ID.
+func (r *databaseWorkspaceResource) policiesTargetingDatabase(ctx context.Context, databaseID string) ([]string, error) {
+	uapAPI := r.providerData.UAPClient
+
+	policies, err := listAllPolicies(ctx, uapAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	var referencing []string
+	for _, listed := range policies {
+		policyID := listed.Metadata.PolicyID
+		var policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy
+		err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
+			var getErr error
+			policy, getErr = uapAPI.Db().Policy(&uapcommonmodels.ArkUAPGetPolicyRequest{PolicyID: policyID})
+			return getErr
+		})
+		if err != nil {
+			// Deleted between list and read
+			if client.IsNotFoundError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("read policy %s: %w", policyID, err)
+		}
+		if findDatabaseInPolicy(policy, databaseID) != nil {
+			referencing = append(referencing, policyID)
+		}
+	}
+
+	tflog.Debug(ctx, "Checked policy references for database workspace", map[string]interface{}{
+		logKeyDatabaseID: databaseID,
+		"policies":       len(referencing),
+	})
+
+	return referencing, nil
+}
+
 // handleCertificateError checks if an error is certificate-related and adds an actionable error diagnostic
 // Returns true if a certificate error was detected and handled, false otherwise
 func handleCertificateError(certificateID types.String, err error, resp interface{}) bool {
@@ -319,6 +500,14 @@ func (r *databaseWorkspaceResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, "Creating database workspace", map[string]interface{}{
 		"name": plan.Name.ValueString(),
 	})
@@ -352,19 +541,19 @@ func (r *databaseWorkspaceResource) Create(ctx context.Context, req resource.Cre
 		Certificate:              plan.CertificateID.ValueString(),
 		ReadWriteEndpoint:        plan.Address.ValueString(),
 		ReadOnlyEndpoint:         plan.ReadOnlyEndpoint.ValueString(),
-		Port:                     int(plan.Port.ValueInt64()),
 		SecretID:                 plan.SecretID.ValueString(),
 		ConfiguredAuthMethodType: plan.AuthenticationMethod.ValueString(),
 		Region:                   plan.Region.ValueString(),
 	}
 
-	// SECURITY: Default to true if not explicitly set (secure by default)
-	if !plan.EnableCertificateValidation.IsNull() {
-		addDatabaseReq.EnableCertificateValidation = plan.EnableCertificateValidation.ValueBool()
-	} else {
-		addDatabaseReq.EnableCertificateValidation = true
+	// Port is only sent when configured so SIA applies the database family default
+	if !plan.Port.IsNull() && !plan.Port.IsUnknown() {
+		addDatabaseReq.Port = int(plan.Port.ValueInt64())
 	}
 
+	// SECURITY: the schema defaults to true, so only an explicit false disables validation
+	addDatabaseReq.EnableCertificateValidation = plan.EnableCertificateValidation.ValueBool()
+
 	// Convert tags from types.Map to map[string]string
 	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
 		tags := make(map[string]string)
@@ -378,11 +567,7 @@ func (r *databaseWorkspaceResource) Create(ctx context.Context, req resource.Cre
 
 	// Wrap SDK call with retry logic per docs/sdk-integration.md
 	var database *dbmodels.ArkSIADBDatabase
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		var apiErr error
 		database, apiErr = r.providerData.SIAAPI.WorkspacesDB().AddDatabase(addDatabaseReq)
 		return apiErr
@@ -390,7 +575,7 @@ func (r *databaseWorkspaceResource) Create(ctx context.Context, req resource.Cre
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to create database workspace", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 
 		// Check for certificate-related errors and provide actionable guidance
@@ -405,16 +590,23 @@ func (r *databaseWorkspaceResource) Create(ctx context.Context, req resource.Cre
 	// Map response to state
 	plan.ID = types.StringValue(strconv.Itoa(database.ID))
 	plan.DatabaseType = types.StringValue(database.ProviderDetails.Engine)
+	if plan.Port.IsNull() || plan.Port.IsUnknown() {
+		plan.Port = types.Int64Value(int64(database.Port))
+	}
+	// Omitted authentication_method is unknown in the plan; SIA picks the family default
+	if plan.AuthenticationMethod.IsUnknown() {
+		plan.AuthenticationMethod = authenticationMethodFromAPI(database, plan.AuthenticationMethod)
+	}
 	// Note: ARK SDK v1.5.0 ArkSIADBDatabase model does not expose last_modified field
 	// The API may track modification time internally, but it's not returned in the response
 	plan.LastModified = types.StringValue("")
 
 	// Log certificate association if configured
 	logFields := map[string]interface{}{
-		"id": plan.ID.ValueString(),
+		logKeyDatabaseID: plan.ID.ValueString(),
 	}
 	if !plan.CertificateID.IsNull() && plan.CertificateID.ValueString() != "" {
-		logFields["certificate_id"] = plan.CertificateID.ValueString()
+		logFields[logKeyCertificateID] = plan.CertificateID.ValueString()
 		tflog.Info(ctx, "Database workspace associated with certificate", logFields)
 	} else {
 		tflog.Info(ctx, "Created database workspace", logFields)
@@ -442,8 +634,16 @@ func (r *databaseWorkspaceResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Reading database workspace", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeyDatabaseID: state.ID.ValueString(),
 	})
 
 	// Convert string ID to int for SDK
@@ -460,11 +660,7 @@ func (r *databaseWorkspaceResource) Read(ctx context.Context, req resource.ReadR
 	// Note: SDK method is "Database", not "GetDatabase"
 	// Handle 404 as resource deleted (drift detection)
 	var database *dbmodels.ArkSIADBDatabase
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		var apiErr error
 		database, apiErr = r.providerData.SIAAPI.WorkspacesDB().Database(&dbmodels.ArkSIADBGetDatabase{
 			ID: databaseID,
@@ -477,14 +673,14 @@ func (r *databaseWorkspaceResource) Read(ctx context.Context, req resource.ReadR
 		// Per sdk-integration.md: Handle 404 as resource deleted
 		if client.IsNotFoundError(err) {
 			tflog.Warn(ctx, "Database workspace not found, removing from state", map[string]interface{}{
-				"id": state.ID.ValueString(),
+				logKeyDatabaseID: state.ID.ValueString(),
 			})
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
 		tflog.Error(ctx, "Failed to read database workspace", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 		resp.Diagnostics.Append(client.MapError(err, "read database workspace"))
 		return
@@ -492,48 +688,38 @@ func (r *databaseWorkspaceResource) Read(ctx context.Context, req resource.ReadR
 
 	// Map response to state - update fields from API response
 	state.Name = types.StringValue(database.Name)
-	state.NetworkName = types.StringValue(database.NetworkName)
 	// Convert Platform from API format back to Terraform format (ON-PREMISE -> on_premise, AWS -> aws, etc.)
 	if database.Platform != "" {
 		state.CloudProvider = types.StringValue(cloudProviderFromAPI(database.Platform))
 	} else {
 		state.CloudProvider = types.StringNull()
 	}
-	state.AuthDatabase = types.StringValue(database.AuthDatabase)
-	state.Account = types.StringValue(database.Account)
 	state.DatabaseType = types.StringValue(database.ProviderDetails.Engine)
 	state.Address = types.StringValue(database.ReadWriteEndpoint)
-	state.ReadOnlyEndpoint = types.StringValue(database.ReadOnlyEndpoint)
 	state.Port = types.Int64Value(int64(database.Port))
 	state.SecretID = types.StringValue(database.SecretID)
 	state.EnableCertificateValidation = types.BoolValue(database.EnableCertificateValidation)
-	state.CertificateID = types.StringValue(database.Certificate)
-	state.Region = types.StringValue(database.Region)
+	optionalStringsFromAPI(database, &state)
+	state.AuthenticationMethod = authenticationMethodFromAPI(database, state.AuthenticationMethod)
 
 	// Convert services []string from SDK to types.List
-	if len(database.Services) > 0 {
-		servicesList, diag := types.ListValueFrom(ctx, types.StringType, database.Services)
-		if diag.HasError() {
-			resp.Diagnostics.Append(diag...)
-			return
-		}
-		state.Services = servicesList
+	servicesList, servicesDiags := servicesFromAPI(ctx, database.Services, state.Services)
+	if servicesDiags.HasError() {
+		resp.Diagnostics.Append(servicesDiags...)
+		return
 	}
+	state.Services = servicesList
 
 	// Convert tags from map[string]string to types.Map
-	if len(database.Tags) > 0 {
-		tagsMap, diag := types.MapValueFrom(ctx, types.StringType, database.Tags)
-		if diag.HasError() {
-			resp.Diagnostics.Append(diag...)
-			return
-		}
-		state.Tags = tagsMap
-	} else {
-		state.Tags = types.MapNull(types.StringType)
+	tagsMap, tagsDiags := tagsFromAPI(ctx, database.Tags, state.Tags)
+	if tagsDiags.HasError() {
+		resp.Diagnostics.Append(tagsDiags...)
+		return
 	}
+	state.Tags = tagsMap
 
 	tflog.Debug(ctx, "Successfully read database workspace", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeyDatabaseID: state.ID.ValueString(),
 	})
 
 	// Save updated data into Terraform state
@@ -559,8 +745,16 @@ func (r *databaseWorkspaceResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, "Updating database workspace", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeyDatabaseID: state.ID.ValueString(),
 	})
 
 	// Convert string ID to int for SDK
@@ -604,19 +798,19 @@ func (r *databaseWorkspaceResource) Update(ctx context.Context, req resource.Upd
 		Certificate:              plan.CertificateID.ValueString(),
 		ReadWriteEndpoint:        plan.Address.ValueString(),
 		ReadOnlyEndpoint:         plan.ReadOnlyEndpoint.ValueString(),
-		Port:                     int(plan.Port.ValueInt64()),
 		SecretID:                 plan.SecretID.ValueString(),
 		ConfiguredAuthMethodType: plan.AuthenticationMethod.ValueString(),
 		Region:                   plan.Region.ValueString(),
 	}
 
-	// SECURITY: Default to true if not explicitly set (secure by default)
-	if !plan.EnableCertificateValidation.IsNull() {
-		updateReq.EnableCertificateValidation = plan.EnableCertificateValidation.ValueBool()
-	} else {
-		updateReq.EnableCertificateValidation = true
+	// Port is only sent when known so the default applied at creation is kept
+	if !plan.Port.IsNull() && !plan.Port.IsUnknown() {
+		updateReq.Port = int(plan.Port.ValueInt64())
 	}
 
+	// SECURITY: the schema defaults to true, so only an explicit false disables validation
+	updateReq.EnableCertificateValidation = plan.EnableCertificateValidation.ValueBool()
+
 	// Convert tags from types.Map to map[string]string
 	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
 		tags := make(map[string]string)
@@ -630,11 +824,7 @@ func (r *databaseWorkspaceResource) Update(ctx context.Context, req resource.Upd
 
 	// Wrap SDK call with retry logic
 	var updated *dbmodels.ArkSIADBDatabase
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		var apiErr error
 		updated, apiErr = r.providerData.SIAAPI.WorkspacesDB().UpdateDatabase(updateReq)
 		return apiErr
@@ -642,7 +832,7 @@ func (r *databaseWorkspaceResource) Update(ctx context.Context, req resource.Upd
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to update database workspace", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 
 		// Check for certificate-related errors and provide actionable guidance
@@ -657,13 +847,19 @@ func (r *databaseWorkspaceResource) Update(ctx context.Context, req resource.Upd
 	// Map response to state
 	plan.ID = types.StringValue(strconv.Itoa(updated.ID))
 	plan.DatabaseType = types.StringValue(updated.ProviderDetails.Engine)
+	if plan.Port.IsNull() || plan.Port.IsUnknown() {
+		plan.Port = types.Int64Value(int64(updated.Port))
+	}
+	if plan.AuthenticationMethod.IsUnknown() {
+		plan.AuthenticationMethod = authenticationMethodFromAPI(updated, plan.AuthenticationMethod)
+	}
 	// Note: ARK SDK v1.5.0 ArkSIADBDatabase model does not expose last_modified field
 	// The API may track modification time internally, but it's not returned in the response
 	plan.LastModified = types.StringValue("")
 
 	// Log certificate association changes if updated
 	logFields := map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeyDatabaseID: state.ID.ValueString(),
 	}
 
 	// Track certificate changes (added, updated, or removed)
@@ -672,7 +868,7 @@ func (r *databaseWorkspaceResource) Update(ctx context.Context, req resource.Upd
 
 	if oldCertID != newCertID {
 		if newCertID != "" {
-			logFields["certificate_id"] = newCertID
+			logFields[logKeyCertificateID] = newCertID
 			if oldCertID != "" {
 				logFields["old_certificate_id"] = oldCertID
 				tflog.Info(ctx, "Database workspace certificate updated", logFields)
@@ -709,8 +905,16 @@ func (r *databaseWorkspaceResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Info(ctx, "Deleting database workspace", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeyDatabaseID: state.ID.ValueString(),
 	})
 
 	// Convert string ID to int for SDK
@@ -727,11 +931,7 @@ func (r *databaseWorkspaceResource) Delete(ctx context.Context, req resource.Del
 	// Use direct HTTP DELETE with empty map workaround instead of SDK method
 	// See internal/client/delete_workarounds.go for details
 	// TODO: Revert to SDK method when v1.6.0+ fixes nil body handling
-	err = client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err = client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		return client.DeleteDatabaseWorkspaceDirect(ctx, r.providerData.AuthContext, databaseID)
 	})
 
@@ -739,20 +939,20 @@ func (r *databaseWorkspaceResource) Delete(ctx context.Context, req resource.Del
 		// Gracefully handle already-deleted resource (404)
 		if client.IsNotFoundError(err) {
 			tflog.Warn(ctx, "Database workspace already deleted", map[string]interface{}{
-				"id": state.ID.ValueString(),
+				logKeyDatabaseID: state.ID.ValueString(),
 			})
 			return
 		}
 
 		tflog.Error(ctx, "Failed to delete database workspace", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 		resp.Diagnostics.Append(client.MapError(err, "delete database workspace"))
 		return
 	}
 
 	tflog.Info(ctx, "Deleted database workspace", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeyDatabaseID: state.ID.ValueString(),
 	})
 }
 
@@ -762,6 +962,6 @@ func (r *databaseWorkspaceResource) ImportState(ctx context.Context, req resourc
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Imported database workspace", map[string]interface{}{
-		"id": req.ID,
+		logKeyDatabaseID: req.ID,
 	})
 }
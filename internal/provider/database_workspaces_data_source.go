@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	dbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/sia/workspaces/db/models"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatabaseWorkspacesDataSource{}
+
+func NewDatabaseWorkspacesDataSource() datasource.DataSource {
+	return &DatabaseWorkspacesDataSource{}
+}
+
+// DatabaseWorkspacesDataSource defines the data source implementation.
+type DatabaseWorkspacesDataSource struct {
+	providerData *ProviderData
+}
+
+// DatabaseWorkspacesDataSourceModel describes the data source data model.
+type DatabaseWorkspacesDataSourceModel struct {
+	// Optional filters
+	NamePrefix    types.String `tfsdk:"name_prefix"`
+	DatabaseType  types.String `tfsdk:"database_type"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+
+	// Computed
+	Workspaces []DatabaseWorkspacesItemModel `tfsdk:"workspaces"`
+}
+
+// DatabaseWorkspacesItemModel describes a single workspace returned by the data source.
+// Attributes mirror cyberarksia_database_workspace (without last_modified).
+type DatabaseWorkspacesItemModel struct {
+	Services                    types.List   `tfsdk:"services"`
+	Tags                        types.Map    `tfsdk:"tags"`
+	ID                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	DatabaseType                types.String `tfsdk:"database_type"`
+	Address                     types.String `tfsdk:"address"`
+	AuthDatabase                types.String `tfsdk:"auth_database"`
+	Account                     types.String `tfsdk:"account"`
+	NetworkName                 types.String `tfsdk:"network_name"`
+	ReadOnlyEndpoint            types.String `tfsdk:"read_only_endpoint"`
+	AuthenticationMethod        types.String `tfsdk:"authentication_method"`
+	SecretID                    types.String `tfsdk:"secret_id"`
+	CertificateID               types.String `tfsdk:"certificate_id"`
+	CloudProvider               types.String `tfsdk:"cloud_provider"`
+	Region                      types.String `tfsdk:"region"`
+	Port                        types.Int64  `tfsdk:"port"`
+	EnableCertificateValidation types.Bool   `tfsdk:"enable_certificate_validation"`
+}
+
+// databaseWorkspacesFilter holds the configured filters in API form
+type databaseWorkspacesFilter struct {
+	namePrefix   string
+	databaseType string
+	platform     string // API Platform value (e.g., ON-PREMISE), empty for any
+}
+
+// Metadata returns the data source type name.
+func (d *DatabaseWorkspacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_workspaces"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabaseWorkspacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists database workspaces registered in SIA, optionally filtered by name prefix, database type, or cloud provider. " +
+			"Use this data source to look up workspace IDs by name or to build policy database assignments with `for_each`.\n\n" +
+			"Workspaces are returned sorted by name. The filters are applied by the provider after the full list is fetched.\n\n" +
+			"**Limitation:** ARK SDK v1.5.0 exposes no paging for the workspace list. If SIA reports more workspaces than it " +
+			"returned in one response, the read fails instead of returning a truncated list, whatever filters are configured. " +
+			"On such tenants, reference workspace IDs directly (e.g. from `cyberarksia_database_workspace` resources) instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return workspaces whose name starts with this prefix (case-sensitive).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"database_type": schema.StringAttribute{
+				MarkdownDescription: "Only return workspaces with this database engine (e.g., `postgres`, `mysql`, `postgres-aws-rds`).",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.DatabaseEngine(),
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only return workspaces hosted on this cloud provider. Valid values: `aws`, `azure`, `gcp`, `on_premise`, `atlas`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("aws", "azure", "gcp", "on_premise", "atlas"),
+				},
+			},
+			"workspaces": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching database workspaces.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "SIA-assigned unique identifier for the database workspace.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Database name on the database server.",
+							Computed:            true,
+						},
+						"database_type": schema.StringAttribute{
+							MarkdownDescription: "Type of database engine.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Hostname, IP address, or FQDN of the database server.",
+							Computed:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "TCP port for database connections.",
+							Computed:            true,
+						},
+						"auth_database": schema.StringAttribute{
+							MarkdownDescription: "Authentication database name.",
+							Computed:            true,
+						},
+						"services": schema.ListAttribute{
+							MarkdownDescription: "List of service names for the database.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"account": schema.StringAttribute{
+							MarkdownDescription: "Account name for provider-based databases.",
+							Computed:            true,
+						},
+						"network_name": schema.StringAttribute{
+							MarkdownDescription: "Network name where the database resides.",
+							Computed:            true,
+						},
+						"read_only_endpoint": schema.StringAttribute{
+							MarkdownDescription: "Read-only endpoint for the database.",
+							Computed:            true,
+						},
+						"authentication_method": schema.StringAttribute{
+							MarkdownDescription: "How SIA authenticates to the database.",
+							Computed:            true,
+						},
+						"secret_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the secret SIA uses to provision ephemeral accounts.",
+							Computed:            true,
+						},
+						"enable_certificate_validation": schema.BoolAttribute{
+							MarkdownDescription: "Whether TLS certificate validation is enforced.",
+							Computed:            true,
+						},
+						"certificate_id": schema.StringAttribute{
+							MarkdownDescription: "Certificate ID used for TLS/mTLS connections.",
+							Computed:            true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							MarkdownDescription: "Cloud provider hosting the database.",
+							Computed:            true,
+						},
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region of the database.",
+							Computed:            true,
+						},
+						"tags": schema.MapAttribute{
+							MarkdownDescription: "Key-value tags on the database workspace.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source with provider data.
+func (d *DatabaseWorkspacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+// Read lists workspaces, applies the filters, and fetches full details for each match.
+func (d *DatabaseWorkspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.providerData == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured ProviderData. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data DatabaseWorkspacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := databaseWorkspacesFilter{
+		namePrefix:   data.NamePrefix.ValueString(),
+		databaseType: data.DatabaseType.ValueString(),
+	}
+	if !data.CloudProvider.IsNull() {
+		filter.platform = cloudProviderToAPI(data.CloudProvider.ValueString())
+	}
+
+	retryConfig := d.providerData.retryConfig()
+
+	var list *dbmodels.ArkSIADBDatabaseInfoList
+	err := client.RetryWithBackoff(ctx, retryConfig, func() error {
+		var apiErr error
+		list, apiErr = d.providerData.SIAAPI.WorkspacesDB().ListDatabases()
+		return apiErr
+	})
+	if err != nil {
+		resp.Diagnostics.Append(client.MapError(err, "list database workspaces"))
+		return
+	}
+
+	if err := checkDatabaseWorkspaceListComplete(list); err != nil {
+		resp.Diagnostics.AddError("Incomplete Database Workspace List", err.Error())
+		return
+	}
+
+	matches := filterDatabaseWorkspaces(list.Items, filter)
+
+	tflog.Debug(ctx, "Listed database workspaces", map[string]interface{}{
+		"total_count": list.TotalCount,
+		"match_count": len(matches),
+	})
+
+	// The list endpoint omits connection details (address, port, region, tags, ...),
+	// so each match is fetched individually
+	data.Workspaces = make([]DatabaseWorkspacesItemModel, 0, len(matches))
+	for _, info := range matches {
+		var database *dbmodels.ArkSIADBDatabase
+		err := client.RetryWithBackoff(ctx, retryConfig, func() error {
+			var apiErr error
+			database, apiErr = d.providerData.SIAAPI.WorkspacesDB().Database(&dbmodels.ArkSIADBGetDatabase{
+				ID: info.ID,
+			})
+			return apiErr
+		})
+		if err != nil {
+			// Deleted between list and get - skip rather than fail the whole read
+			if client.IsNotFoundError(err) {
+				tflog.Warn(ctx, "Database workspace disappeared while listing, skipping", map[string]interface{}{
+					logKeyDatabaseID: info.ID,
+				})
+				continue
+			}
+			resp.Diagnostics.Append(client.MapError(err, fmt.Sprintf("read database workspace %d", info.ID)))
+			return
+		}
+
+		item, diags := databaseWorkspacesItemFromAPI(ctx, database)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Workspaces = append(data.Workspaces, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// checkDatabaseWorkspaceListComplete returns an error when the list response holds fewer
+// items than its total_count. SDK v1.5.0 exposes no paging parameters for this endpoint, so a
+// short response cannot be continued and must not be returned as if it were complete.
+// It runs on the unfiltered response, so the data source filters cannot avoid it.
+func checkDatabaseWorkspaceListComplete(list *dbmodels.ArkSIADBDatabaseInfoList) error {
+	if list == nil {
+		return fmt.Errorf("SIA returned an empty response when listing database workspaces")
+	}
+	if len(list.Items) < list.TotalCount {
+		return fmt.Errorf("SIA returned %d of %d database workspaces; refusing to return a truncated list. "+
+			"The workspace list cannot be paged with ARK SDK v1.5.0, and the data source filters are applied after this check, "+
+			"so changing them does not help. Reference workspace IDs directly instead of using this data source on this tenant",
+			len(list.Items), list.TotalCount)
+	}
+	return nil
+}
+
+// filterDatabaseWorkspaces returns the workspaces matching filter, sorted by name then ID
+func filterDatabaseWorkspaces(items []dbmodels.ArkSIADBDatabaseInfo, filter databaseWorkspacesFilter) []dbmodels.ArkSIADBDatabaseInfo {
+	matches := make([]dbmodels.ArkSIADBDatabaseInfo, 0, len(items))
+	for _, info := range items {
+		if filter.namePrefix != "" && !strings.HasPrefix(info.Name, filter.namePrefix) {
+			continue
+		}
+		if filter.databaseType != "" && info.ProviderInfo.Engine != filter.databaseType {
+			continue
+		}
+		if filter.platform != "" && info.Platform != filter.platform {
+			continue
+		}
+		matches = append(matches, info)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	return matches
+}
+
+// databaseWorkspacesItemFromAPI maps a workspace to the data source item model
+// Field mapping matches databaseWorkspaceResource.Read: unset optional fields are null, not ""
+func databaseWorkspacesItemFromAPI(ctx context.Context, database *dbmodels.ArkSIADBDatabase) (DatabaseWorkspacesItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	item := DatabaseWorkspacesItemModel{
+		ID:                          types.StringValue(strconv.Itoa(database.ID)),
+		Name:                        types.StringValue(database.Name),
+		DatabaseType:                types.StringValue(database.ProviderDetails.Engine),
+		Address:                     types.StringValue(database.ReadWriteEndpoint),
+		Port:                        types.Int64Value(int64(database.Port)),
+		AuthDatabase:                optionalStringFromAPI(database.AuthDatabase),
+		Account:                     optionalStringFromAPI(database.Account),
+		NetworkName:                 optionalStringFromAPI(database.NetworkName),
+		ReadOnlyEndpoint:            optionalStringFromAPI(database.ReadOnlyEndpoint),
+		AuthenticationMethod:        authenticationMethodFromAPI(database, types.StringNull()),
+		SecretID:                    types.StringValue(database.SecretID),
+		CertificateID:               optionalStringFromAPI(database.Certificate),
+		Region:                      optionalStringFromAPI(database.Region),
+		EnableCertificateValidation: types.BoolValue(database.EnableCertificateValidation),
+		CloudProvider:               types.StringNull(),
+	}
+	if database.Platform != "" {
+		item.CloudProvider = types.StringValue(cloudProviderFromAPI(database.Platform))
+	}
+
+	services, servicesDiags := servicesFromAPI(ctx, database.Services, types.ListNull(types.StringType))
+	diags.Append(servicesDiags...)
+	item.Services = services
+
+	tags, tagsDiags := tagsFromAPI(ctx, database.Tags, types.MapNull(types.StringType))
+	diags.Append(tagsDiags...)
+	item.Tags = tags
+
+	return item, diags
+}
@@ -0,0 +1,215 @@
+// Package provider implements tests for database_workspaces_data_source
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	dbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/sia/workspaces/db/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDatabaseWorkspacesDataSource_filters tests listing workspaces with each filter
+// Validates:
+// - name_prefix returns only the workspaces created by this test, sorted by name
+// - database_type and cloud_provider narrow the result further
+// - Full workspace details (address, port) are returned, not just list fields
+func TestAccDatabaseWorkspacesDataSource_filters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseWorkspacesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// name_prefix only
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_prefix", "workspaces.#", "2"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_workspaces.by_prefix", "workspaces.0.id",
+						"cyberarksia_database_workspace.list_mysql", "id"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_database_workspaces.by_prefix", "workspaces.1.id",
+						"cyberarksia_database_workspace.list_postgres", "id"),
+
+					// name_prefix + database_type
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_type", "workspaces.#", "1"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_type", "workspaces.0.name", "tf-acc-list-postgres"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_type", "workspaces.0.address", "postgres-list.example.com"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_type", "workspaces.0.port", "5432"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_type", "workspaces.0.authentication_method", "local_ephemeral_user"),
+
+					// name_prefix + cloud_provider
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_cloud", "workspaces.#", "1"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_cloud", "workspaces.0.name", "tf-acc-list-mysql"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_cloud", "workspaces.0.cloud_provider", "aws"),
+					resource.TestCheckResourceAttr("data.cyberarksia_database_workspaces.by_cloud", "workspaces.0.region", "us-east-1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatabaseWorkspacesDataSourceConfig = `
+resource "cyberarksia_secret" "list" {
+  name                = "tf-acc-list-secret"
+  authentication_type = "local"
+  username            = "db_user"
+  password            = "SecurePassword123!"
+}
+
+resource "cyberarksia_database_workspace" "list_postgres" {
+  name                  = "tf-acc-list-postgres"
+  database_type         = "postgres"
+  address               = "postgres-list.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = cyberarksia_secret.list.id
+}
+
+resource "cyberarksia_database_workspace" "list_mysql" {
+  name                  = "tf-acc-list-mysql"
+  database_type         = "mysql"
+  address               = "mysql-list.example.com"
+  port                  = 3306
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "aws"
+  region                = "us-east-1"
+  secret_id             = cyberarksia_secret.list.id
+}
+
+data "cyberarksia_database_workspaces" "by_prefix" {
+  name_prefix = "tf-acc-list-"
+
+  depends_on = [cyberarksia_database_workspace.list_postgres, cyberarksia_database_workspace.list_mysql]
+}
+
+data "cyberarksia_database_workspaces" "by_type" {
+  name_prefix   = "tf-acc-list-"
+  database_type = "postgres"
+
+  depends_on = [cyberarksia_database_workspace.list_postgres, cyberarksia_database_workspace.list_mysql]
+}
+
+data "cyberarksia_database_workspaces" "by_cloud" {
+  name_prefix    = "tf-acc-list-"
+  cloud_provider = "aws"
+
+  depends_on = [cyberarksia_database_workspace.list_postgres, cyberarksia_database_workspace.list_mysql]
+}
+`
+
+func TestFilterDatabaseWorkspaces(t *testing.T) {
+	items := []dbmodels.ArkSIADBDatabaseInfo{
+		{ID: 3, Name: "prod-pg", Platform: "AWS", ProviderInfo: dbmodels.ArkSIADBDatabaseProvider{Engine: "postgres-aws-rds"}},
+		{ID: 1, Name: "prod-mysql", Platform: "ON-PREMISE", ProviderInfo: dbmodels.ArkSIADBDatabaseProvider{Engine: "mysql"}},
+		{ID: 2, Name: "dev-pg", Platform: "ON-PREMISE", ProviderInfo: dbmodels.ArkSIADBDatabaseProvider{Engine: "postgres"}},
+		{ID: 4, Name: "prod-pg", Platform: "ON-PREMISE", ProviderInfo: dbmodels.ArkSIADBDatabaseProvider{Engine: "postgres"}},
+	}
+
+	tests := []struct {
+		name    string
+		filter  databaseWorkspacesFilter
+		wantIDs []int
+	}{
+		{name: "no filter sorts by name then id", filter: databaseWorkspacesFilter{}, wantIDs: []int{2, 1, 3, 4}},
+		{name: "name prefix", filter: databaseWorkspacesFilter{namePrefix: "prod-"}, wantIDs: []int{1, 3, 4}},
+		{name: "name prefix is case-sensitive", filter: databaseWorkspacesFilter{namePrefix: "PROD-"}, wantIDs: []int{}},
+		{name: "database type is exact", filter: databaseWorkspacesFilter{databaseType: "postgres"}, wantIDs: []int{2, 4}},
+		{name: "platform", filter: databaseWorkspacesFilter{platform: "ON-PREMISE"}, wantIDs: []int{2, 1, 4}},
+		{name: "combined", filter: databaseWorkspacesFilter{namePrefix: "prod-", databaseType: "postgres", platform: "ON-PREMISE"}, wantIDs: []int{4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIDs := []int{}
+			for _, info := range filterDatabaseWorkspaces(items, tt.filter) {
+				gotIDs = append(gotIDs, info.ID)
+			}
+			if diff := cmp.Diff(tt.wantIDs, gotIDs); diff != "" {
+				t.Errorf("filterDatabaseWorkspaces() IDs mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckDatabaseWorkspaceListComplete(t *testing.T) {
+	items := []dbmodels.ArkSIADBDatabaseInfo{{ID: 1}, {ID: 2}}
+
+	if err := checkDatabaseWorkspaceListComplete(&dbmodels.ArkSIADBDatabaseInfoList{Items: items, TotalCount: 2}); err != nil {
+		t.Errorf("complete list: unexpected error %v", err)
+	}
+	if err := checkDatabaseWorkspaceListComplete(&dbmodels.ArkSIADBDatabaseInfoList{Items: nil, TotalCount: 0}); err != nil {
+		t.Errorf("empty list: unexpected error %v", err)
+	}
+
+	err := checkDatabaseWorkspaceListComplete(&dbmodels.ArkSIADBDatabaseInfoList{Items: items, TotalCount: 150})
+	if err == nil || !strings.Contains(err.Error(), "2 of 150") {
+		t.Errorf("truncated list: error = %v, want it to mention 2 of 150", err)
+	}
+
+	if err := checkDatabaseWorkspaceListComplete(nil); err == nil {
+		t.Error("nil list: expected error")
+	}
+}
+
+func TestDatabaseWorkspacesItemFromAPI(t *testing.T) {
+	database := &dbmodels.ArkSIADBDatabase{
+		ID:                          42,
+		Name:                        "orders",
+		Platform:                    "ON-PREMISE",
+		ReadWriteEndpoint:           "orders.example.com",
+		Port:                        5432,
+		SecretID:                    "secret-1",
+		EnableCertificateValidation: true,
+		Services:                    []string{"svc"},
+		ProviderDetails:             dbmodels.ArkSIADBDatabaseProvider{Engine: "postgres"},
+	}
+
+	item, diags := databaseWorkspacesItemFromAPI(context.Background(), database)
+	if diags.HasError() {
+		t.Fatalf("databaseWorkspacesItemFromAPI() diagnostics: %v", diags)
+	}
+
+	if item.ID.ValueString() != "42" {
+		t.Errorf("ID = %s, want 42", item.ID)
+	}
+	if item.CloudProvider.ValueString() != "on_premise" {
+		t.Errorf("CloudProvider = %s, want on_premise", item.CloudProvider)
+	}
+	if item.Address.ValueString() != "orders.example.com" || item.Port.ValueInt64() != 5432 {
+		t.Errorf("Address/Port = %s/%s", item.Address, item.Port)
+	}
+	if len(item.Services.Elements()) != 1 {
+		t.Errorf("Services = %s, want one element", item.Services)
+	}
+	if !item.Tags.IsNull() {
+		t.Errorf("Tags = %s, want null when the API returns none", item.Tags)
+	}
+	if !item.AuthenticationMethod.IsNull() {
+		t.Errorf("AuthenticationMethod = %s, want null when the API returns none", item.AuthenticationMethod)
+	}
+
+	// Unset optional fields are null, as in the resource, so items can be passed back to it
+	optional := map[string]types.String{
+		"auth_database":      item.AuthDatabase,
+		"account":            item.Account,
+		"network_name":       item.NetworkName,
+		"read_only_endpoint": item.ReadOnlyEndpoint,
+		"certificate_id":     item.CertificateID,
+		"region":             item.Region,
+	}
+	for field, value := range optional {
+		if !value.IsNull() {
+			t.Errorf("%s = %s, want null when the API returns \"\"", field, value)
+		}
+	}
+
+	database.Certificate = "1234567890123456"
+	database.Region = "us-east-1"
+	item, _ = databaseWorkspacesItemFromAPI(context.Background(), database)
+	if item.CertificateID.ValueString() != "1234567890123456" || item.Region.ValueString() != "us-east-1" {
+		t.Errorf("CertificateID/Region = %s/%s, want the API values", item.CertificateID, item.Region)
+	}
+}
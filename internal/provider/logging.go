@@ -7,6 +7,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// Standard tflog field names. Use these instead of string literals so that the
+// same identifier is logged under the same key across all resources.
+const (
+	logKeyPolicyID      = "policy_id"
+	logKeyDatabaseID    = "database_id" // Database workspace ID
+	logKeyPrincipalID   = "principal_id"
+	logKeySecretID      = "secret_id"
+	logKeyCertificateID = "certificate_id"
+	logKeyResourceType  = "resource_type"
+	logKeyResourceID    = "resource_id" // Terraform resource ID (may be composite)
+	logKeyOperation     = "operation"
+	logKeyError         = "error"
+)
+
 // SensitiveFields are fields that should NEVER be logged
 var SensitiveFields = []string{
 	"password",
@@ -66,30 +80,31 @@ func LogIdentityClientSuccess(ctx context.Context) {
 	tflog.Info(ctx, "Successfully initialized Identity API client")
 }
 
-// LogOperationStart logs the start of an API operation
-func LogOperationStart(ctx context.Context, operation string, resourceType string) {
-	tflog.Debug(ctx, "Starting operation", map[string]interface{}{
-		"operation":     operation,
-		"resource_type": resourceType,
-	})
+// LogOperationStart logs the start of an API operation.
+// Optional fields (e.g. logKeyPolicyID) are merged into the log entry.
+func LogOperationStart(ctx context.Context, operation string, resourceType string, fields ...map[string]interface{}) {
+	tflog.Debug(ctx, "Starting operation", mergeLogFields(map[string]interface{}{
+		logKeyOperation:    operation,
+		logKeyResourceType: resourceType,
+	}, fields...))
 }
 
 // LogOperationSuccess logs successful completion of an API operation
-func LogOperationSuccess(ctx context.Context, operation string, resourceType string, resourceID string) {
-	tflog.Info(ctx, "Operation completed successfully", map[string]interface{}{
-		"operation":     operation,
-		"resource_type": resourceType,
-		"resource_id":   resourceID,
-	})
+func LogOperationSuccess(ctx context.Context, operation string, resourceType string, resourceID string, fields ...map[string]interface{}) {
+	tflog.Info(ctx, "Operation completed successfully", mergeLogFields(map[string]interface{}{
+		logKeyOperation:    operation,
+		logKeyResourceType: resourceType,
+		logKeyResourceID:   resourceID,
+	}, fields...))
 }
 
 // LogOperationError logs operation failure
-func LogOperationError(ctx context.Context, operation string, resourceType string, err error) {
-	tflog.Error(ctx, "Operation failed", map[string]interface{}{
-		"operation":     operation,
-		"resource_type": resourceType,
-		"error":         err.Error(),
-	})
+func LogOperationError(ctx context.Context, operation string, resourceType string, err error, fields ...map[string]interface{}) {
+	tflog.Error(ctx, "Operation failed", mergeLogFields(map[string]interface{}{
+		logKeyOperation:    operation,
+		logKeyResourceType: resourceType,
+		logKeyError:        err.Error(),
+	}, fields...))
 }
 
 // LogRetryAttempt logs retry attempt with backoff info
@@ -102,9 +117,22 @@ func LogRetryAttempt(ctx context.Context, attempt int, maxRetries int, delay str
 }
 
 // LogDriftDetected logs when state drift is detected
-func LogDriftDetected(ctx context.Context, resourceType string, resourceID string) {
-	tflog.Warn(ctx, "State drift detected - resource modified outside Terraform", map[string]interface{}{
-		"resource_type": resourceType,
-		"resource_id":   resourceID,
-	})
+func LogDriftDetected(ctx context.Context, resourceType string, resourceID string, fields ...map[string]interface{}) {
+	tflog.Warn(ctx, "State drift detected - resource modified outside Terraform", mergeLogFields(map[string]interface{}{
+		logKeyResourceType: resourceType,
+		logKeyResourceID:   resourceID,
+	}, fields...))
+}
+
+// mergeLogFields adds extra fields to base. Base fields win on key collisions so
+// callers cannot overwrite the standard operation/resource fields.
+func mergeLogFields(base map[string]interface{}, extra ...map[string]interface{}) map[string]interface{} {
+	for _, fields := range extra {
+		for k, v := range fields {
+			if _, exists := base[k]; !exists {
+				base[k] = v
+			}
+		}
+	}
+	return base
 }
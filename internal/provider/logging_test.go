@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// captureLogs runs fn with a JSON root logger and returns the decoded log entries
+func captureLogs(t *testing.T, fn func(ctx context.Context)) []map[string]interface{} {
+	t.Helper()
+
+	var output bytes.Buffer
+	fn(tflogtest.RootLogger(context.Background(), &output))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %s", err)
+	}
+	return entries
+}
+
+// Test that the operation helpers log the standard field names
+func TestLogHelpers_FieldNames(t *testing.T) {
+	extra := map[string]interface{}{
+		logKeyPolicyID:   "policy-123",
+		logKeyDatabaseID: "42",
+	}
+
+	tests := []struct {
+		name     string
+		log      func(ctx context.Context)
+		expected map[string]interface{}
+	}{
+		{
+			name: "LogOperationStart",
+			log: func(ctx context.Context) {
+				LogOperationStart(ctx, "create", "policy_database_assignment", extra)
+			},
+			expected: map[string]interface{}{
+				logKeyOperation:    "create",
+				logKeyResourceType: "policy_database_assignment",
+				logKeyPolicyID:     "policy-123",
+				logKeyDatabaseID:   "42",
+			},
+		},
+		{
+			name: "LogOperationSuccess",
+			log: func(ctx context.Context) {
+				LogOperationSuccess(ctx, "read", "policy_database_assignment", "policy-123:42", extra)
+			},
+			expected: map[string]interface{}{
+				logKeyOperation:    "read",
+				logKeyResourceType: "policy_database_assignment",
+				logKeyResourceID:   "policy-123:42",
+				logKeyPolicyID:     "policy-123",
+				logKeyDatabaseID:   "42",
+			},
+		},
+		{
+			name: "LogOperationError",
+			log: func(ctx context.Context) {
+				LogOperationError(ctx, "delete", "policy_database_assignment", errors.New("boom"))
+			},
+			expected: map[string]interface{}{
+				logKeyOperation:    "delete",
+				logKeyResourceType: "policy_database_assignment",
+				logKeyError:        "boom",
+			},
+		},
+		{
+			name: "LogDriftDetected",
+			log: func(ctx context.Context) {
+				LogDriftDetected(ctx, "policy_database_assignment", "policy-123:42", extra)
+			},
+			expected: map[string]interface{}{
+				logKeyResourceType: "policy_database_assignment",
+				logKeyResourceID:   "policy-123:42",
+				logKeyPolicyID:     "policy-123",
+				logKeyDatabaseID:   "42",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := captureLogs(t, tt.log)
+			if len(entries) != 1 {
+				t.Fatalf("expected 1 log entry, got %d", len(entries))
+			}
+
+			for key, want := range tt.expected {
+				got, ok := entries[0][key]
+				if !ok {
+					t.Errorf("missing log field %q in %v", key, entries[0])
+					continue
+				}
+				if got != want {
+					t.Errorf("log field %q = %v, want %v", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+// Test that extra fields cannot overwrite the standard fields
+func TestLogHelpers_ExtraFieldsDoNotOverride(t *testing.T) {
+	entries := captureLogs(t, func(ctx context.Context) {
+		LogOperationSuccess(ctx, "update", "database_policy", "policy-123", map[string]interface{}{
+			logKeyResourceID: "overridden",
+			logKeyOperation:  "overridden",
+		})
+	})
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(entries))
+	}
+
+	if got := entries[0][logKeyResourceID]; got != "policy-123" {
+		t.Errorf("%s = %v, want %q", logKeyResourceID, got, "policy-123")
+	}
+	if got := entries[0][logKeyOperation]; got != "update" {
+		t.Errorf("%s = %v, want %q", logKeyOperation, got, "update")
+	}
+}
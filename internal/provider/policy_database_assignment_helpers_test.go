@@ -1,10 +1,20 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
 	"github.com/aaearon/terraform-provider-cyberark-sia/internal/provider/helpers"
+	uapcommonmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/common/models"
 	uapsiadbmodels "github.com/cyberark/ark-sdk-golang/pkg/services/uap/sia/db/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Test composite ID building
@@ -40,12 +50,15 @@ func TestBuildCompositeID(t *testing.T) {
 }
 
 // Test composite ID parsing
+// IDs are split with SplitN(id, ":", 2), so any colons after the first belong to the
+// database ID ("uuid:123:extra" parses to database ID "123:extra") and are not an error.
 func TestParseCompositeID(t *testing.T) {
 	tests := []struct {
 		name         string // 16 bytes
 		id           string // 16 bytes
 		wantPolicyID string // 16 bytes
 		wantDBID     string // 16 bytes
+		wantErrMsg   string // 16 bytes
 		wantErr      bool   // 1 byte
 	}{
 		{
@@ -63,19 +76,34 @@ func TestParseCompositeID(t *testing.T) {
 			wantErr:      false,
 		},
 		{
-			name:    "missing colon",
-			id:      "policy-123-database-456",
-			wantErr: true,
+			name:       "missing colon",
+			id:         "policy-123-database-456",
+			wantErr:    true,
+			wantErrMsg: "invalid composite ID format: expected 2 parts separated by ':', got 1 parts in 'policy-123-database-456'",
 		},
 		{
-			name:    "empty string",
-			id:      "",
-			wantErr: true,
+			name:       "empty string",
+			id:         "",
+			wantErr:    true,
+			wantErrMsg: "invalid composite ID format: expected 2 parts separated by ':', got 1 parts in ''",
 		},
 		{
-			name:    "only colon",
-			id:      ":",
-			wantErr: true,
+			name:       "only colon",
+			id:         ":",
+			wantErr:    true,
+			wantErrMsg: "invalid composite ID format: part 1 is empty in ':'",
+		},
+		{
+			name:       "empty policy ID",
+			id:         ":123",
+			wantErr:    true,
+			wantErrMsg: "invalid composite ID format: part 1 is empty in ':123'",
+		},
+		{
+			name:       "empty database ID",
+			id:         "12345678-1234-1234-1234-123456789012:",
+			wantErr:    true,
+			wantErrMsg: "invalid composite ID format: part 2 is empty in '12345678-1234-1234-1234-123456789012:'",
 		},
 		{
 			name:         "multiple colons (takes first)",
@@ -84,6 +112,13 @@ func TestParseCompositeID(t *testing.T) {
 			wantDBID:     "123:extra",
 			wantErr:      false,
 		},
+		{
+			name:         "UUID with extra colon part",
+			id:           "12345678-1234-1234-1234-123456789012:123:extra",
+			wantPolicyID: "12345678-1234-1234-1234-123456789012",
+			wantDBID:     "123:extra",
+			wantErr:      false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,13 +128,20 @@ func TestParseCompositeID(t *testing.T) {
 				t.Errorf("ParsePolicyDatabaseID() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !tt.wantErr {
-				if gotPolicyID != tt.wantPolicyID {
-					t.Errorf("ParsePolicyDatabaseID() policyID = %v, want %v", gotPolicyID, tt.wantPolicyID)
+			if tt.wantErr {
+				if err.Error() != tt.wantErrMsg {
+					t.Errorf("ParsePolicyDatabaseID() error = %q, want %q", err.Error(), tt.wantErrMsg)
 				}
-				if gotDBID != tt.wantDBID {
-					t.Errorf("ParsePolicyDatabaseID() dbID = %v, want %v", gotDBID, tt.wantDBID)
+				if gotPolicyID != "" || gotDBID != "" {
+					t.Errorf("ParsePolicyDatabaseID() = (%q, %q), want empty values on error", gotPolicyID, gotDBID)
 				}
+				return
+			}
+			if gotPolicyID != tt.wantPolicyID {
+				t.Errorf("ParsePolicyDatabaseID() policyID = %v, want %v", gotPolicyID, tt.wantPolicyID)
+			}
+			if gotDBID != tt.wantDBID {
+				t.Errorf("ParsePolicyDatabaseID() dbID = %v, want %v", gotDBID, tt.wantDBID)
 			}
 		})
 	}
@@ -307,3 +349,200 @@ func TestFindDatabaseInPolicyWithType(t *testing.T) {
 		})
 	}
 }
+
+// Test ValidateConfig requires the profile block matching authentication_method
+func TestDatabasePolicyDatabaseAssignmentResource_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		model      models.DatabasePolicyDatabaseAssignmentModel
+		wantDetail string
+	}{
+		{
+			name: "mongo_auth with profile",
+			model: models.DatabasePolicyDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("mongo_auth"),
+				MongoAuthProfile: &models.MongoAuthProfileModel{
+					GlobalBuiltinRoles:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("readAnyDatabase")}),
+					DatabaseBuiltinRoles: types.MapNull(types.ListType{ElemType: types.StringType}),
+					DatabaseCustomRoles:  types.MapNull(types.ListType{ElemType: types.StringType}),
+				},
+			},
+		},
+		{
+			name: "mongo_auth without profile",
+			model: models.DatabasePolicyDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("mongo_auth"),
+			},
+			wantDetail: "mongo_auth_profile block is required when authentication_method is 'mongo_auth'",
+		},
+		{
+			name: "db_auth without profile",
+			model: models.DatabasePolicyDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("db_auth"),
+			},
+			wantDetail: "db_auth_profile block is required when authentication_method is 'db_auth'",
+		},
+		{
+			name: "sqlserver_auth without profile",
+			model: models.DatabasePolicyDatabaseAssignmentModel{
+				AuthenticationMethod: types.StringValue("sqlserver_auth"),
+			},
+			wantDetail: "sqlserver_auth_profile block is required when authentication_method is 'sqlserver_auth'",
+		},
+	}
+
+	ctx := context.Background()
+	r := &DatabasePolicyDatabaseAssignmentResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := tt.model
+			model.PolicyID = types.StringValue("12345678-1234-1234-1234-123456789012")
+			model.DatabaseWorkspaceID = types.StringValue("101")
+			model.ID = types.StringNull()
+			model.LastModified = types.StringNull()
+			model.Timeouts = nullTimeouts()
+
+			// Config has no setter; build the raw value through State
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("building config: %v", diags)
+			}
+
+			resp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+			}, &resp)
+
+			errs := resp.Diagnostics.Errors()
+			if tt.wantDetail == "" {
+				if len(errs) != 0 {
+					t.Fatalf("ValidateConfig() unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("ValidateConfig() got %d errors, want 1: %v", len(errs), errs)
+			}
+			if errs[0].Summary() != "Missing Authentication Profile" {
+				t.Errorf("summary = %q, want %q", errs[0].Summary(), "Missing Authentication Profile")
+			}
+			if errs[0].Detail() != tt.wantDetail {
+				t.Errorf("detail = %q, want %q", errs[0].Detail(), tt.wantDetail)
+			}
+		})
+	}
+}
+
+// Test ImportState rejects IDs that are not policy-id:database-id
+func TestDatabasePolicyDatabaseAssignmentResource_ImportState(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantID  string
+		wantErr bool
+	}{
+		{name: "valid UUID and numeric ID", id: "12345678-1234-1234-1234-123456789012:101", wantID: "12345678-1234-1234-1234-123456789012:101"},
+		{name: "uppercase policy ID stored lowercase", id: "ABCDEF12-1234-1234-1234-123456789ABC:101", wantID: "abcdef12-1234-1234-1234-123456789abc:101"},
+		{name: "extra segment", id: "12345678-1234-1234-1234-123456789012:101:extra", wantErr: true},
+		{name: "non-numeric database ID", id: "12345678-1234-1234-1234-123456789012:abc", wantErr: true},
+		{name: "not a UUID", id: "---:1", wantErr: true},
+		{name: "non-hex policy ID", id: "1234567g-1234-1234-1234-123456789012:101", wantErr: true},
+		{name: "missing database ID", id: "12345678-1234-1234-1234-123456789012", wantErr: true},
+		{name: "empty", id: "", wantErr: true},
+	}
+
+	ctx := context.Background()
+	r := &DatabasePolicyDatabaseAssignmentResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("ImportState(%q) error = %v, want %v: %v", tt.id, got, tt.wantErr, resp.Diagnostics)
+			}
+			if tt.wantErr {
+				if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Invalid Import ID" {
+					t.Errorf("summary = %q, want %q", summary, "Invalid Import ID")
+				}
+				return
+			}
+
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id.ValueString() != tt.wantID {
+				t.Errorf("id = %s, want %q", id, tt.wantID)
+			}
+		})
+	}
+}
+
+// stubDatabasePolicyAPI returns a fixed error from Policy and counts UpdatePolicy calls
+type stubDatabasePolicyAPI struct {
+	policyErr   error
+	updateCalls int
+}
+
+func (s *stubDatabasePolicyAPI) Policy(*uapcommonmodels.ArkUAPGetPolicyRequest) (*uapsiadbmodels.ArkUAPSIADBAccessPolicy, error) {
+	return nil, s.policyErr
+}
+
+func (s *stubDatabasePolicyAPI) UpdatePolicy(policy *uapsiadbmodels.ArkUAPSIADBAccessPolicy) (*uapsiadbmodels.ArkUAPSIADBAccessPolicy, error) {
+	s.updateCalls++
+	return policy, nil
+}
+
+// Test that Delete treats a policy that is already gone as deleted, using the same
+// not-found classification as Read, and fails on other fetch errors
+func TestDatabasePolicyDatabaseAssignmentResource_DeletePolicyGone(t *testing.T) {
+	tests := []struct {
+		name      string
+		policyErr error
+		wantErr   bool
+	}{
+		{name: "sdk 404", policyErr: errors.New("failed to get policy - [404] - []")},
+		{name: "server error", policyErr: errors.New("failed to get policy - [500] - []"), wantErr: true},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policyAPI := &stubDatabasePolicyAPI{policyErr: tt.policyErr}
+			r := &DatabasePolicyDatabaseAssignmentResource{
+				providerData: &ProviderData{},
+				policyAPI:    policyAPI,
+			}
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := state.SetAttribute(ctx, path.Root("id"), "12345678-1234-1234-1234-123456789012:101"); diags.HasError() {
+				t.Fatalf("SetAttribute(id) diagnostics: %v", diags)
+			}
+
+			resp := resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("Delete() error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+			if policyAPI.updateCalls != 0 {
+				t.Errorf("UpdatePolicy called %d times, want 0", policyAPI.updateCalls)
+			}
+		})
+	}
+}
@@ -82,6 +82,12 @@ type PrincipalDataSourceModel struct {
 	DisplayName   types.String `tfsdk:"display_name"`   // Human-readable name
 	Email         types.String `tfsdk:"email"`          // Email (users only, optional)
 	Description   types.String `tfsdk:"description"`    // Description (optional)
+
+	// Computed aliases named after the policy principal attributes
+	PrincipalID         types.String `tfsdk:"principal_id"`          // Same as id
+	PrincipalName       types.String `tfsdk:"principal_name"`        // SystemName as stored in the directory
+	SourceDirectoryName types.String `tfsdk:"source_directory_name"` // Same as directory_name
+	SourceDirectoryID   types.String `tfsdk:"source_directory_id"`   // Same as directory_id
 }
 
 // Metadata returns the data source type name.
@@ -94,7 +100,8 @@ func (d *PrincipalDataSource) Schema(ctx context.Context, req datasource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Looks up a principal (user, group, or role) by name from CyberArk Identity directories. " +
 			"Supports Cloud Directory (CDS), Federated Directory (FDS/Entra ID), and Active Directory (AdProxy). " +
-			"Use this data source to get principal information for policy assignments.",
+			"Use this data source to get principal information for policy assignments. Set `type` to `GROUP` or `ROLE` " +
+			"to look up AD/LDAP groups and Identity roles; these are resolved through the directory entity list.",
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -136,6 +143,22 @@ func (d *PrincipalDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "The principal's description (optional, may be empty).",
 				Computed:            true,
 			},
+			"principal_id": schema.StringAttribute{
+				MarkdownDescription: "Same as `id`. Matches the `principal_id` argument of policy principals.",
+				Computed:            true,
+			},
+			"principal_name": schema.StringAttribute{
+				MarkdownDescription: "The principal's SystemName with the directory's casing. Matches the `principal_name` argument of policy principals.",
+				Computed:            true,
+			},
+			"source_directory_name": schema.StringAttribute{
+				MarkdownDescription: "Same as `directory_name`. Matches the `source_directory_name` argument of policy principals.",
+				Computed:            true,
+			},
+			"source_directory_id": schema.StringAttribute{
+				MarkdownDescription: "Same as `directory_id`. Matches the `source_directory_id` argument of policy principals.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -236,10 +259,10 @@ func (d *PrincipalDataSource) Read(ctx context.Context, req datasource.ReadReque
 						d.populateDataModel(&data, userEntity, dirMap)
 						found = true
 						tflog.Info(ctx, "Principal lookup succeeded", map[string]interface{}{
-							"principal_id":   data.ID.ValueString(),
-							"principal_type": data.PrincipalType.ValueString(),
-							"directory_name": data.DirectoryName.ValueString(),
-							"path":           "phase1_fast",
+							logKeyPrincipalID: data.ID.ValueString(),
+							"principal_type":  data.PrincipalType.ValueString(),
+							"directory_name":  data.DirectoryName.ValueString(),
+							"path":            "phase1_fast",
 						})
 						break
 					}
@@ -331,10 +354,10 @@ func (d *PrincipalDataSource) Read(ctx context.Context, req datasource.ReadReque
 	d.populateDataModel(&data, matchedEntity, dirMap)
 
 	tflog.Info(ctx, "Principal lookup succeeded", map[string]interface{}{
-		"principal_id":   data.ID.ValueString(),
-		"principal_type": data.PrincipalType.ValueString(),
-		"directory_name": data.DirectoryName.ValueString(),
-		"path":           "phase2_fallback",
+		logKeyPrincipalID: data.ID.ValueString(),
+		"principal_type":  data.PrincipalType.ValueString(),
+		"directory_name":  data.DirectoryName.ValueString(),
+		"path":            "phase2_fallback",
 	})
 
 	// Save data into Terraform state
@@ -479,6 +502,9 @@ func (d *PrincipalDataSource) getDirectoriesAndEntities(ctx context.Context, typ
 //	// data.ID = "user-uuid"
 //	// data.DirectoryID = dirMap["CDS"]
 //	// data.Email = types.StringValue("user@domain.com") or types.StringNull()
+//
+// The principal_id, principal_name and source_directory_* aliases are set for every
+// type so the result can be passed attribute-for-attribute into a policy principal.
 func (d *PrincipalDataSource) populateDataModel(data *PrincipalDataSourceModel, entity directoriesmodels.ArkIdentityEntity, dirMap map[string]string) {
 	switch e := entity.(type) {
 	case *directoriesmodels.ArkIdentityUserEntity:
@@ -520,4 +546,22 @@ func (d *PrincipalDataSource) populateDataModel(data *PrincipalDataSourceModel,
 			data.Description = types.StringNull()
 		}
 	}
+
+	data.PrincipalID = data.ID
+	data.PrincipalName = types.StringValue(entityName(entity))
+	data.SourceDirectoryName = data.DirectoryName
+	data.SourceDirectoryID = data.DirectoryID
+}
+
+// entityName returns the SystemName of a user, group, or role entity
+func entityName(entity directoriesmodels.ArkIdentityEntity) string {
+	switch e := entity.(type) {
+	case *directoriesmodels.ArkIdentityUserEntity:
+		return e.Name
+	case *directoriesmodels.ArkIdentityGroupEntity:
+		return e.Name
+	case *directoriesmodels.ArkIdentityRoleEntity:
+		return e.Name
+	}
+	return ""
 }
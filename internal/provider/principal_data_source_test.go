@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	directoriesmodels "github.com/cyberark/ark-sdk-golang/pkg/services/identity/directories/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -149,10 +150,12 @@ func TestAccPrincipalDataSource_Role(t *testing.T) {
 	})
 }
 
-// TestAccPrincipalDataSource_WithPolicyAssignment tests integration with policy assignment
+// TestAccPrincipalDataSource_WithPolicyAssignment tests a GROUP lookup feeding a principal assignment
+// Validates:
+// - type = "GROUP" resolves through the directory entity list
+// - principal_id/principal_name/source_directory_* aliases pass straight into the assignment
+// - The assignment persists the group's directory metadata
 func TestAccPrincipalDataSource_WithPolicyAssignment(t *testing.T) {
-	t.Skip("Integration test - requires full database + policy setup - run manually")
-
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -161,16 +164,107 @@ func TestAccPrincipalDataSource_WithPolicyAssignment(t *testing.T) {
 				Config: testAccPrincipalDataSourceConfigWithPolicyAssignment,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// Principal lookup
-					resource.TestCheckResourceAttrSet("data.cyberarksia_principal.policy_user", "id"),
-					resource.TestCheckResourceAttr("data.cyberarksia_principal.policy_user", "principal_type", "USER"),
+					resource.TestCheckResourceAttr("data.cyberarksia_principal.policy_group", "principal_type", "GROUP"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_principal.policy_group", "principal_id",
+						"data.cyberarksia_principal.policy_group", "id"),
+					resource.TestCheckResourceAttrPair("data.cyberarksia_principal.policy_group", "source_directory_id",
+						"data.cyberarksia_principal.policy_group", "directory_id"),
 					// Policy assignment using principal data
-					resource.TestCheckResourceAttrSet("cyberark_sia_database_policy_principal_assignment.test", "id"),
+					resource.TestCheckResourceAttrPair("cyberarksia_database_policy_principal_assignment.group", "principal_id",
+						"data.cyberarksia_principal.policy_group", "id"),
+					resource.TestCheckResourceAttr("cyberarksia_database_policy_principal_assignment.group", "principal_type", "GROUP"),
+					resource.TestCheckResourceAttrPair("cyberarksia_database_policy_principal_assignment.group", "source_directory_name",
+						"data.cyberarksia_principal.policy_group", "directory_name"),
 				),
 			},
 		},
 	})
 }
 
+// TestPrincipalDataSource_populateDataModel tests state mapping for each principal type
+func TestPrincipalDataSource_populateDataModel(t *testing.T) {
+	dirMap := map[string]string{"CDS": "cds-uuid", "AdProxy": "ad-uuid"}
+	base := func(id, name, entityType, dirType, dirName string) directoriesmodels.ArkIdentityBaseEntity {
+		return directoriesmodels.ArkIdentityBaseEntity{
+			ID:                       id,
+			Name:                     name,
+			EntityType:               entityType,
+			DirectoryServiceType:     dirType,
+			DisplayName:              name + " display",
+			ServiceInstanceLocalized: dirName,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		entity      directoriesmodels.ArkIdentityEntity
+		wantType    string
+		wantName    string
+		wantDirName string
+		wantDirID   string
+		wantEmail   bool
+	}{
+		{
+			name: "USER",
+			entity: &directoriesmodels.ArkIdentityUserEntity{
+				ArkIdentityBaseEntity: base("user-1", "jane@example.com", "USER", "CDS", "CyberArk Cloud Directory"),
+				Email:                 "jane@example.com",
+			},
+			wantType:    "USER",
+			wantName:    "jane@example.com",
+			wantDirName: "CyberArk Cloud Directory",
+			wantDirID:   "cds-uuid",
+			wantEmail:   true,
+		},
+		{
+			name: "GROUP",
+			entity: &directoriesmodels.ArkIdentityGroupEntity{
+				ArkIdentityBaseEntity: base("group-1", "DB Admins", "GROUP", "AdProxy", "Active Directory (corp.example.com)"),
+			},
+			wantType:    "GROUP",
+			wantName:    "DB Admins",
+			wantDirName: "Active Directory (corp.example.com)",
+			wantDirID:   "ad-uuid",
+		},
+		{
+			name: "ROLE",
+			entity: &directoriesmodels.ArkIdentityRoleEntity{
+				ArkIdentityBaseEntity: base("role-1", "System Administrator", "ROLE", "CDS", "CyberArk Cloud Directory"),
+			},
+			wantType:    "ROLE",
+			wantName:    "System Administrator",
+			wantDirName: "CyberArk Cloud Directory",
+			wantDirID:   "cds-uuid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data PrincipalDataSourceModel
+			(&PrincipalDataSource{}).populateDataModel(&data, tt.entity, dirMap)
+
+			if data.PrincipalType.ValueString() != tt.wantType {
+				t.Errorf("principal_type = %s, want %s", data.PrincipalType, tt.wantType)
+			}
+			if data.ID.IsNull() || !data.PrincipalID.Equal(data.ID) {
+				t.Errorf("principal_id = %s, want id %s", data.PrincipalID, data.ID)
+			}
+			if data.PrincipalName.ValueString() != tt.wantName {
+				t.Errorf("principal_name = %s, want %s", data.PrincipalName, tt.wantName)
+			}
+			if data.DirectoryName.ValueString() != tt.wantDirName || !data.SourceDirectoryName.Equal(data.DirectoryName) {
+				t.Errorf("directory_name/source_directory_name = %s/%s, want %s", data.DirectoryName, data.SourceDirectoryName, tt.wantDirName)
+			}
+			if data.DirectoryID.ValueString() != tt.wantDirID || !data.SourceDirectoryID.Equal(data.DirectoryID) {
+				t.Errorf("directory_id/source_directory_id = %s/%s, want %s", data.DirectoryID, data.SourceDirectoryID, tt.wantDirID)
+			}
+			if data.Email.IsNull() == tt.wantEmail {
+				t.Errorf("email = %s, want set: %t", data.Email, tt.wantEmail)
+			}
+		})
+	}
+}
+
 // Test configurations
 
 const testAccPrincipalDataSourceConfigCloudUser = `
@@ -232,20 +326,69 @@ data "cyberarksia_principal" "role" {
 `
 
 const testAccPrincipalDataSourceConfigWithPolicyAssignment = `
+resource "cyberarksia_secret" "principal_group" {
+  name                = "test-principal-group-secret"
+  authentication_type = "local"
+  username            = "db_user"
+  password            = "TestPassword123!"
+}
+
+resource "cyberarksia_database_workspace" "principal_group" {
+  name                  = "test-principal-group-db"
+  database_type         = "postgres"
+  address               = "postgres-principal-group.example.com"
+  port                  = 5432
+  authentication_method = "local_ephemeral_user"
+  cloud_provider        = "on_premise"
+  secret_id             = cyberarksia_secret.principal_group.id
+}
+
 data "cyberarksia_principal" "policy_user" {
   name = "tim.schindler@cyberark.cloud.40562"
+  type = "USER"
+}
+
+data "cyberarksia_principal" "policy_group" {
+  name = "CyberArk Guardians"
+  type = "GROUP"
 }
 
-data "cyberark_sia_access_policy" "existing_policy" {
-  name = "Production Database Policy"
+resource "cyberarksia_database_policy" "principal_group" {
+  name   = "test-principal-group-policy"
+  status = "active"
+
+  conditions {
+    max_session_duration = 8
+  }
+
+  target_database {
+    database_workspace_id = cyberarksia_database_workspace.principal_group.id
+    authentication_method = "db_auth"
+
+    db_auth_profile {
+      roles = ["readonly"]
+    }
+  }
+
+  principal {
+    principal_id          = data.cyberarksia_principal.policy_user.principal_id
+    principal_type        = data.cyberarksia_principal.policy_user.principal_type
+    principal_name        = data.cyberarksia_principal.policy_user.principal_name
+    source_directory_name = data.cyberarksia_principal.policy_user.source_directory_name
+    source_directory_id   = data.cyberarksia_principal.policy_user.source_directory_id
+  }
+
+  lifecycle {
+    ignore_changes = [principal]
+  }
 }
 
-resource "cyberark_sia_database_policy_principal_assignment" "test" {
-  policy_id               = data.cyberark_sia_access_policy.existing_policy.id
-  principal_id            = data.cyberarksia_principal.policy_user.id
-  principal_type          = data.cyberarksia_principal.policy_user.principal_type
-  principal_name          = data.cyberarksia_principal.policy_user.name
-  source_directory_name   = data.cyberarksia_principal.policy_user.directory_name
-  source_directory_id     = data.cyberarksia_principal.policy_user.directory_id
+resource "cyberarksia_database_policy_principal_assignment" "group" {
+  policy_id             = cyberarksia_database_policy.principal_group.id
+  principal_id          = data.cyberarksia_principal.policy_group.principal_id
+  principal_type        = data.cyberarksia_principal.policy_group.principal_type
+  principal_name        = data.cyberarksia_principal.policy_group.principal_name
+  source_directory_name = data.cyberarksia_principal.policy_group.source_directory_name
+  source_directory_id   = data.cyberarksia_principal.policy_group.source_directory_id
 }
 `
@@ -30,6 +30,14 @@ func BuildAuthenticationProfile(
 	data *models.DatabasePolicyDatabaseAssignmentModel,
 	diagnostics *diag.Diagnostics,
 ) interface{} {
+	if data == nil {
+		diagnostics.AddError(
+			"Missing Assignment Data",
+			"Cannot build authentication profile from nil assignment data. Please report this issue to the provider developers.",
+		)
+		return nil
+	}
+
 	switch authMethod {
 	case "db_auth":
 		return buildDBAuthProfile(ctx, data, diagnostics)
@@ -348,7 +356,12 @@ func parseOracleAuthProfile(ctx context.Context, target *uapsiadbmodels.ArkUAPSI
 // parseMongoAuthProfile handles mongo_auth profile parsing
 func parseMongoAuthProfile(ctx context.Context, target *uapsiadbmodels.ArkUAPSIADBInstanceTarget, data *models.DatabasePolicyDatabaseAssignmentModel, diagnostics *diag.Diagnostics) {
 	if target.MongoAuthProfile != nil {
-		mongoModel := &models.MongoAuthProfileModel{}
+		// Unset fields stay typed nulls (zero-value lists/maps have no element type)
+		mongoModel := &models.MongoAuthProfileModel{
+			GlobalBuiltinRoles:   types.ListNull(types.StringType),
+			DatabaseBuiltinRoles: types.MapNull(types.ListType{ElemType: types.StringType}),
+			DatabaseCustomRoles:  types.MapNull(types.ListType{ElemType: types.StringType}),
+		}
 
 		// Global builtin roles
 		if len(target.MongoAuthProfile.GlobalBuiltinRoles) > 0 {
@@ -387,7 +400,13 @@ func parseMongoAuthProfile(ctx context.Context, target *uapsiadbmodels.ArkUAPSIA
 // parseSQLServerAuthProfile handles sqlserver_auth profile parsing
 func parseSQLServerAuthProfile(ctx context.Context, target *uapsiadbmodels.ArkUAPSIADBInstanceTarget, data *models.DatabasePolicyDatabaseAssignmentModel, diagnostics *diag.Diagnostics) {
 	if target.SQLServerAuthProfile != nil {
-		sqlModel := &models.SQLServerAuthProfileModel{}
+		// Unset fields stay typed nulls (zero-value lists/maps have no element type)
+		sqlModel := &models.SQLServerAuthProfileModel{
+			GlobalBuiltinRoles:   types.ListNull(types.StringType),
+			GlobalCustomRoles:    types.ListNull(types.StringType),
+			DatabaseBuiltinRoles: types.MapNull(types.ListType{ElemType: types.StringType}),
+			DatabaseCustomRoles:  types.MapNull(types.ListType{ElemType: types.StringType}),
+		}
 
 		// Global builtin roles
 		if len(target.SQLServerAuthProfile.GlobalBuiltinRoles) > 0 {
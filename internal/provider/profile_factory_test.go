@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aaearon/terraform-provider-cyberark-sia/internal/models"
@@ -609,14 +611,285 @@ func TestBuildAuthenticationProfile_RDSIAMUserAuth_Missing(t *testing.T) {
 	}
 }
 
-// TODO: Add tests for ParseAuthenticationProfile function:
-// - TestParseAuthenticationProfile_DBAuth
-// - TestParseAuthenticationProfile_LDAPAuth
-// - TestParseAuthenticationProfile_OracleAuth
-// - TestParseAuthenticationProfile_MongoAuth
-// - TestParseAuthenticationProfile_SQLServerAuth
-// - TestParseAuthenticationProfile_RDSIAMUserAuth
-
-// TODO: Add round-trip tests:
-// - TestProfileRoundTrip_DBAuth (Build -> Parse -> Build should be idempotent)
-// - Similar tests for other auth methods
+// TestBuildAuthenticationProfile_AllMethods tests every auth method builds the expected type
+// and that SetProfileOnInstanceTarget populates exactly one profile field
+func TestBuildAuthenticationProfile_AllMethods(t *testing.T) {
+	roles := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("reader")})
+
+	tests := []struct {
+		authMethod string
+		data       *models.DatabasePolicyDatabaseAssignmentModel
+		wantType   string
+		profileSet func(*uapsiadbmodels.ArkUAPSIADBInstanceTarget) bool
+	}{
+		{
+			authMethod: "db_auth",
+			data:       &models.DatabasePolicyDatabaseAssignmentModel{DBAuthProfile: &models.DBAuthProfileModel{Roles: roles}},
+			wantType:   "*models.ArkUAPSIADBDBAuthProfile",
+			profileSet: func(it *uapsiadbmodels.ArkUAPSIADBInstanceTarget) bool { return it.DBAuthProfile != nil },
+		},
+		{
+			authMethod: "ldap_auth",
+			data:       &models.DatabasePolicyDatabaseAssignmentModel{LDAPAuthProfile: &models.LDAPAuthProfileModel{AssignGroups: roles}},
+			wantType:   "*models.ArkUAPSIADBLDAPAuthProfile",
+			profileSet: func(it *uapsiadbmodels.ArkUAPSIADBInstanceTarget) bool { return it.LDAPAuthProfile != nil },
+		},
+		{
+			authMethod: "oracle_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{OracleAuthProfile: &models.OracleAuthProfileModel{
+				Roles:       roles,
+				DbaRole:     types.BoolValue(false),
+				SysdbaRole:  types.BoolValue(false),
+				SysoperRole: types.BoolValue(false),
+			}},
+			wantType:   "*models.ArkUAPSIADBOracleAuthProfile",
+			profileSet: func(it *uapsiadbmodels.ArkUAPSIADBInstanceTarget) bool { return it.OracleAuthProfile != nil },
+		},
+		{
+			authMethod: "mongo_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{MongoAuthProfile: &models.MongoAuthProfileModel{
+				GlobalBuiltinRoles:   roles,
+				DatabaseBuiltinRoles: types.MapNull(types.ListType{ElemType: types.StringType}),
+				DatabaseCustomRoles:  types.MapNull(types.ListType{ElemType: types.StringType}),
+			}},
+			wantType:   "*models.ArkUAPSIADBMongoAuthProfile",
+			profileSet: func(it *uapsiadbmodels.ArkUAPSIADBInstanceTarget) bool { return it.MongoAuthProfile != nil },
+		},
+		{
+			authMethod: "sqlserver_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{SQLServerAuthProfile: &models.SQLServerAuthProfileModel{
+				GlobalBuiltinRoles:   roles,
+				GlobalCustomRoles:    types.ListNull(types.StringType),
+				DatabaseBuiltinRoles: types.MapNull(types.ListType{ElemType: types.StringType}),
+				DatabaseCustomRoles:  types.MapNull(types.ListType{ElemType: types.StringType}),
+			}},
+			wantType:   "*models.ArkUAPSIADBSqlServerAuthProfile",
+			profileSet: func(it *uapsiadbmodels.ArkUAPSIADBInstanceTarget) bool { return it.SQLServerAuthProfile != nil },
+		},
+		{
+			authMethod: "rds_iam_user_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{RDSIAMUserAuthProfile: &models.RDSIAMUserAuthProfileModel{
+				DBUser: types.StringValue("iam_user"),
+			}},
+			wantType:   "*models.ArkUAPSIADBRDSIAMUserAuthProfile",
+			profileSet: func(it *uapsiadbmodels.ArkUAPSIADBInstanceTarget) bool { return it.RDSIAMUserAuthProfile != nil },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.authMethod, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			profile := BuildAuthenticationProfile(context.Background(), tt.authMethod, tt.data, &diags)
+			if diags.HasError() {
+				t.Fatalf("Expected no errors, got: %v", diags.Errors())
+			}
+			if got := fmt.Sprintf("%T", profile); got != tt.wantType {
+				t.Fatalf("Expected %s, got %s", tt.wantType, got)
+			}
+
+			instanceTarget := &uapsiadbmodels.ArkUAPSIADBInstanceTarget{
+				InstanceName:         "test-db",
+				AuthenticationMethod: tt.authMethod,
+			}
+			SetProfileOnInstanceTarget(instanceTarget, tt.authMethod, profile)
+
+			if !tt.profileSet(instanceTarget) {
+				t.Errorf("Expected %s profile to be set on instance target", tt.authMethod)
+			}
+			if n := countInstanceTargetProfiles(instanceTarget); n != 1 {
+				t.Errorf("Expected exactly 1 profile set on instance target, got %d", n)
+			}
+		})
+	}
+}
+
+// TestBuildAuthenticationProfile_NilData tests that nil assignment data yields a diagnostic instead of a panic
+func TestBuildAuthenticationProfile_NilData(t *testing.T) {
+	for _, authMethod := range []string{"db_auth", "ldap_auth", "oracle_auth", "mongo_auth", "sqlserver_auth", "rds_iam_user_auth"} {
+		t.Run(authMethod, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			profile := BuildAuthenticationProfile(context.Background(), authMethod, nil, &diags)
+			if profile != nil {
+				t.Errorf("Expected nil profile, got %T", profile)
+			}
+			if !diags.HasError() {
+				t.Fatal("Expected error for nil assignment data, got none")
+			}
+			if summary := diags.Errors()[0].Summary(); summary != "Missing Assignment Data" {
+				t.Errorf("Expected error summary 'Missing Assignment Data', got %q", summary)
+			}
+		})
+	}
+}
+
+// countInstanceTargetProfiles returns how many profile fields are set on an instance target
+func countInstanceTargetProfiles(it *uapsiadbmodels.ArkUAPSIADBInstanceTarget) int {
+	count := 0
+	if it.DBAuthProfile != nil {
+		count++
+	}
+	if it.LDAPAuthProfile != nil {
+		count++
+	}
+	if it.OracleAuthProfile != nil {
+		count++
+	}
+	if it.MongoAuthProfile != nil {
+		count++
+	}
+	if it.SQLServerAuthProfile != nil {
+		count++
+	}
+	if it.RDSIAMUserAuthProfile != nil {
+		count++
+	}
+	return count
+}
+
+// TestProfileRoundTrip tests that Parse(Build(model)) reproduces the original model for every auth method
+func TestProfileRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	roleListType := types.ListType{ElemType: types.StringType}
+	stringList := func(values ...string) types.List {
+		elems := make([]attr.Value, 0, len(values))
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+
+	tests := []struct {
+		authMethod string
+		data       *models.DatabasePolicyDatabaseAssignmentModel
+	}{
+		{
+			authMethod: "db_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				DBAuthProfile: &models.DBAuthProfileModel{Roles: stringList("db_reader", "db_writer")},
+			},
+		},
+		{
+			authMethod: "ldap_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				LDAPAuthProfile: &models.LDAPAuthProfileModel{AssignGroups: stringList("DBAdmins")},
+			},
+		},
+		{
+			authMethod: "oracle_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				OracleAuthProfile: &models.OracleAuthProfileModel{
+					Roles:       stringList("CONNECT", "RESOURCE"),
+					DbaRole:     types.BoolValue(true),
+					SysdbaRole:  types.BoolValue(false),
+					SysoperRole: types.BoolValue(true),
+				},
+			},
+		},
+		{
+			authMethod: "mongo_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				MongoAuthProfile: &models.MongoAuthProfileModel{
+					GlobalBuiltinRoles: stringList("readAnyDatabase"),
+					DatabaseBuiltinRoles: types.MapValueMust(roleListType, map[string]attr.Value{
+						"db1": stringList("read"),
+						"db2": stringList("readWrite", "dbAdmin"),
+					}),
+					DatabaseCustomRoles: types.MapValueMust(roleListType, map[string]attr.Value{
+						"db1": stringList("appReporter"),
+					}),
+				},
+			},
+		},
+		{
+			authMethod: "mongo_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				MongoAuthProfile: &models.MongoAuthProfileModel{
+					GlobalBuiltinRoles:   types.ListNull(types.StringType),
+					DatabaseBuiltinRoles: types.MapValueMust(roleListType, map[string]attr.Value{"db1": stringList("read")}),
+					DatabaseCustomRoles:  types.MapNull(roleListType),
+				},
+			},
+		},
+		{
+			authMethod: "sqlserver_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				SQLServerAuthProfile: &models.SQLServerAuthProfileModel{
+					GlobalBuiltinRoles: stringList("sysadmin"),
+					GlobalCustomRoles:  stringList("monitoring"),
+					DatabaseBuiltinRoles: types.MapValueMust(roleListType, map[string]attr.Value{
+						"Production": stringList("db_owner"),
+						"Reporting":  stringList("db_datareader", "db_datawriter"),
+					}),
+					DatabaseCustomRoles: types.MapValueMust(roleListType, map[string]attr.Value{
+						"Production": stringList("app_reporter"),
+					}),
+				},
+			},
+		},
+		{
+			authMethod: "sqlserver_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				SQLServerAuthProfile: &models.SQLServerAuthProfileModel{
+					GlobalBuiltinRoles:   types.ListNull(types.StringType),
+					GlobalCustomRoles:    types.ListNull(types.StringType),
+					DatabaseBuiltinRoles: types.MapNull(roleListType),
+					DatabaseCustomRoles:  types.MapValueMust(roleListType, map[string]attr.Value{"Production": stringList("app_reporter")}),
+				},
+			},
+		},
+		{
+			authMethod: "rds_iam_user_auth",
+			data: &models.DatabasePolicyDatabaseAssignmentModel{
+				RDSIAMUserAuthProfile: &models.RDSIAMUserAuthProfileModel{DBUser: types.StringValue("iam_db_user")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.authMethod, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			profile := BuildAuthenticationProfile(ctx, tt.authMethod, tt.data, &diags)
+			if diags.HasError() {
+				t.Fatalf("Build: expected no errors, got: %v", diags.Errors())
+			}
+
+			instanceTarget := &uapsiadbmodels.ArkUAPSIADBInstanceTarget{
+				InstanceName:         "test-db",
+				AuthenticationMethod: tt.authMethod,
+			}
+			SetProfileOnInstanceTarget(instanceTarget, tt.authMethod, profile)
+
+			parsed := &models.DatabasePolicyDatabaseAssignmentModel{}
+			ParseAuthenticationProfile(ctx, instanceTarget, parsed, &diags)
+			if diags.HasError() {
+				t.Fatalf("Parse: expected no errors, got: %v", diags.Errors())
+			}
+
+			if !reflect.DeepEqual(parsed, tt.data) {
+				t.Errorf("Round trip mismatch:\n got:  %+v\n want: %+v", profileModelOf(parsed), profileModelOf(tt.data))
+			}
+		})
+	}
+}
+
+// profileModelOf returns the single populated profile block of an assignment model for error output
+func profileModelOf(data *models.DatabasePolicyDatabaseAssignmentModel) interface{} {
+	switch {
+	case data.DBAuthProfile != nil:
+		return *data.DBAuthProfile
+	case data.LDAPAuthProfile != nil:
+		return *data.LDAPAuthProfile
+	case data.OracleAuthProfile != nil:
+		return *data.OracleAuthProfile
+	case data.MongoAuthProfile != nil:
+		return *data.MongoAuthProfile
+	case data.SQLServerAuthProfile != nil:
+		return *data.SQLServerAuthProfile
+	case data.RDSIAMUserAuthProfile != nil:
+		return *data.RDSIAMUserAuthProfile
+	}
+	return nil
+}
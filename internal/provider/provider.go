@@ -3,14 +3,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
 	"github.com/cyberark/ark-sdk-golang/pkg/services/identity"
 	"github.com/cyberark/ark-sdk-golang/pkg/services/sia"
 	"github.com/cyberark/ark-sdk-golang/pkg/services/uap"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,9 +35,18 @@ type CyberArkSIAProvider struct {
 
 // CyberArkSIAProviderModel describes the provider data model
 type CyberArkSIAProviderModel struct {
-	Username    types.String `tfsdk:"username"`
-	Password    types.String `tfsdk:"password"`
-	IdentityURL types.String `tfsdk:"identity_url"`
+	Username    types.String        `tfsdk:"username"`
+	Password    types.String        `tfsdk:"password"`
+	IdentityURL types.String        `tfsdk:"identity_url"`
+	Retry       *ProviderRetryModel `tfsdk:"retry"`
+}
+
+// ProviderRetryModel describes the provider retry block
+type ProviderRetryModel struct {
+	MaxRetries         types.Int64 `tfsdk:"max_retries"`
+	BaseDelayMs        types.Int64 `tfsdk:"base_delay_ms"`
+	MaxDelayMs         types.Int64 `tfsdk:"max_delay_ms"`
+	RetryOnStatusCodes types.List  `tfsdk:"retry_on_status_codes"`
 }
 
 // ProviderData holds the ARK SDK instances shared with resources
@@ -53,6 +68,21 @@ type ProviderData struct {
 	// CertificatesClient handles certificate CRUD operations
 	// Initialized on-demand by certificate resource Configure()
 	CertificatesClient *client.CertificatesClient
+
+	// Version is the provider version (exposed by the tenant_info data source)
+	Version string
+
+	// RetryConfig holds the backoff settings from the provider retry block
+	RetryConfig *client.RetryConfig
+}
+
+// retryConfig returns the retry settings for SDK calls, falling back to the
+// client defaults when the provider was configured without them (e.g. in tests)
+func (d *ProviderData) retryConfig() *client.RetryConfig {
+	if d == nil || d.RetryConfig == nil {
+		return client.DefaultRetryConfig()
+	}
+	return d.RetryConfig
 }
 
 // New is a helper function to simplify provider server and testing implementation
@@ -109,9 +139,88 @@ func (p *CyberArkSIAProvider) Schema(ctx context.Context, req provider.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				Description: "Retry behaviour for transient SIA API failures. " +
+					"Widen the backoff when the tenant rate-limits aggressively.",
+				Attributes: map[string]schema.Attribute{
+					"max_retries": schema.Int64Attribute{
+						Description: "Maximum number of retries after the first attempt. Default: 3.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 10),
+						},
+					},
+					"base_delay_ms": schema.Int64Attribute{
+						Description: "Delay before the first retry in milliseconds, doubled on each attempt. Default: 500.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_delay_ms": schema.Int64Attribute{
+						Description: "Upper bound for the delay between retries in milliseconds. " +
+							"Must not be less than base_delay_ms. Default: 10000.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"retry_on_status_codes": schema.ListAttribute{
+						Description: "HTTP status codes that are retried. An empty list (`[]`) turns off retries on status codes. " +
+							"Errors without a status code (e.g. network failures) are always evaluated by the built-in rules. " +
+							"Default: [429, 500, 502, 503, 504].",
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
+// retryConfigFromModel builds the client retry configuration from the provider
+// retry block, applying the client defaults for unset attributes
+func retryConfigFromModel(ctx context.Context, m *ProviderRetryModel) (*client.RetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := client.DefaultRetryConfig()
+	if m == nil {
+		return config, diags
+	}
+
+	if !m.MaxRetries.IsNull() {
+		config.MaxRetries = m.MaxRetries.ValueInt64()
+	}
+	if !m.BaseDelayMs.IsNull() {
+		config.BaseDelay = time.Duration(m.BaseDelayMs.ValueInt64()) * time.Millisecond
+	}
+	if !m.MaxDelayMs.IsNull() {
+		config.MaxDelay = time.Duration(m.MaxDelayMs.ValueInt64()) * time.Millisecond
+	}
+	if !m.RetryOnStatusCodes.IsNull() {
+		var codes []int64
+		diags.Append(m.RetryOnStatusCodes.ElementsAs(ctx, &codes, false)...)
+		config.RetryableStatusCodes = make([]int, len(codes))
+		for i, code := range codes {
+			config.RetryableStatusCodes[i] = int(code)
+		}
+	}
+
+	if config.MaxDelay < config.BaseDelay {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("max_delay_ms"),
+			"Invalid Retry Configuration",
+			fmt.Sprintf("max_delay_ms (%d) must not be less than base_delay_ms (%d).",
+				config.MaxDelay.Milliseconds(), config.BaseDelay.Milliseconds()),
+		)
+	}
+
+	return config, diags
+}
+
 // Configure prepares a CyberArk SIA API client for data sources and resources
 func (p *CyberArkSIAProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config CyberArkSIAProviderModel
@@ -145,6 +254,12 @@ func (p *CyberArkSIAProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	retryConfig, retryDiags := retryConfigFromModel(ctx, config.Retry)
+	resp.Diagnostics.Append(retryDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Log configuration (without sensitive data)
 	LogProviderConfig(ctx, &config)
 
@@ -198,6 +313,8 @@ func (p *CyberArkSIAProvider) Configure(ctx context.Context, req provider.Config
 		SIAAPI:         siaAPI,
 		UAPClient:      uapAPI,
 		IdentityClient: identityAPI,
+		Version:        p.version,
+		RetryConfig:    retryConfig,
 	}
 
 	// Make provider data available to resources and data sources
@@ -220,8 +337,14 @@ func (p *CyberArkSIAProvider) Resources(ctx context.Context) []func() resource.R
 // DataSources defines the data sources implemented in the provider
 func (p *CyberArkSIAProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCertificateDataSource,
+		NewDatabasePoliciesDataSource,
 		NewDatabasePolicyDataSource,
+		NewDatabasePolicyPrincipalAssignmentsDataSource,
+		NewDatabaseWorkspacesDataSource,
 		NewPrincipalDataSource,
+		NewSecretDataSource,
+		NewTenantInfoDataSource,
 	}
 }
 
@@ -2,11 +2,22 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -34,3 +45,256 @@ func testAccPreCheck(t *testing.T) {
 		}
 	}
 }
+
+// testAccProviderData authenticates with the acceptance test credentials and
+// returns SDK clients for making direct API calls outside of Terraform.
+// Used by tests that simulate out-of-band changes (drift) in PreConfig.
+func testAccProviderData(t *testing.T) *ProviderData {
+	t.Helper()
+
+	ctx := context.Background()
+
+	authCtx, err := client.NewISPAuth(ctx, &client.AuthConfig{
+		Username:    os.Getenv(EnvUsername),
+		Password:    os.Getenv(EnvPassword),
+		IdentityURL: os.Getenv(EnvIdentityURL),
+	})
+	if err != nil {
+		t.Fatalf("failed to authenticate for direct API access: %s", err)
+	}
+
+	siaAPI, err := client.NewSIAClient(ctx, authCtx)
+	if err != nil {
+		t.Fatalf("failed to initialize SIA client: %s", err)
+	}
+
+	uapAPI, err := client.NewUAPClient(ctx, authCtx)
+	if err != nil {
+		t.Fatalf("failed to initialize UAP client: %s", err)
+	}
+
+	return &ProviderData{
+		AuthContext: authCtx,
+		SIAAPI:      siaAPI,
+		UAPClient:   uapAPI,
+	}
+}
+
+// testAccCaptureResourceID stores the ID of a resource from state into target
+// so that later steps (e.g. PreConfig) can reference it
+func testAccCaptureResourceID(resourceName string, target *string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource ID not set: %s", resourceName)
+		}
+		*target = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckResourceIDChanged verifies that a resource's ID differs from the one
+// previously captured with testAccCaptureResourceID (i.e. the resource was replaced)
+func testAccCheckResourceIDChanged(resourceName string, previous *string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", resourceName)
+		}
+		if rs.Primary.ID == *previous {
+			return fmt.Errorf("expected %s to be replaced, but ID is unchanged: %s", resourceName, *previous)
+		}
+		return nil
+	}
+}
+
+// testAccCaptureResourceAttr stores a single attribute value of a resource from state
+// into target so that later steps can compare against it
+func testAccCaptureResourceAttr(resourceName, key string, target *string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", resourceName)
+		}
+		value, ok := rs.Primary.Attributes[key]
+		if !ok || value == "" {
+			return fmt.Errorf("attribute %s not set on %s", key, resourceName)
+		}
+		*target = value
+		return nil
+	}
+}
+
+// testAccCheckResourceAttrChanged verifies that a resource attribute differs from the value
+// previously captured with testAccCaptureResourceAttr
+func testAccCheckResourceAttrChanged(resourceName, key string, previous *string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", resourceName)
+		}
+		if value := rs.Primary.Attributes[key]; value == *previous {
+			return fmt.Errorf("expected %s.%s to change, but it is unchanged: %s", resourceName, key, *previous)
+		}
+		return nil
+	}
+}
+
+// testAccCaptureResourceAttributes stores all flatmapped attributes of a resource or
+// data source from state into target so that later steps can reference them
+func testAccCaptureResourceAttributes(resourceName string, target *map[string]string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", resourceName)
+		}
+		*target = rs.Primary.Attributes
+		return nil
+	}
+}
+
+// TestProvider_Metadata tests that the version injected via ldflags is exposed in provider metadata
+func TestProvider_Metadata(t *testing.T) {
+	resp := &provider.MetadataResponse{}
+	New("test-version")().Metadata(context.Background(), provider.MetadataRequest{}, resp)
+
+	if resp.TypeName != "cyberarksia" {
+		t.Errorf("TypeName = %q, want %q", resp.TypeName, "cyberarksia")
+	}
+	if resp.Version != "test-version" {
+		t.Errorf("Version = %q, want %q", resp.Version, "test-version")
+	}
+}
+
+// TestRetryConfigFromModel tests that retry block values are converted into the
+// client RetryConfig that resources pass to RetryWithBackoff
+func TestRetryConfigFromModel(t *testing.T) {
+	ctx := context.Background()
+	defaults := client.DefaultRetryConfig()
+
+	tests := []struct {
+		name      string
+		model     *ProviderRetryModel
+		want      *client.RetryConfig
+		wantError bool
+	}{
+		{
+			name:  "no retry block uses defaults",
+			model: nil,
+			want:  defaults,
+		},
+		{
+			name: "unset attributes use defaults",
+			model: &ProviderRetryModel{
+				MaxRetries:         types.Int64Null(),
+				BaseDelayMs:        types.Int64Null(),
+				MaxDelayMs:         types.Int64Null(),
+				RetryOnStatusCodes: types.ListNull(types.Int64Type),
+			},
+			want: defaults,
+		},
+		{
+			name: "configured values override defaults",
+			model: &ProviderRetryModel{
+				MaxRetries:  types.Int64Value(6),
+				BaseDelayMs: types.Int64Value(2000),
+				MaxDelayMs:  types.Int64Value(60000),
+				RetryOnStatusCodes: types.ListValueMust(types.Int64Type, []attr.Value{
+					types.Int64Value(429),
+					types.Int64Value(503),
+				}),
+			},
+			want: &client.RetryConfig{
+				MaxRetries:           6,
+				BaseDelay:            2 * time.Second,
+				MaxDelay:             time.Minute,
+				JitterFraction:       defaults.JitterFraction,
+				RetryableStatusCodes: []int{429, 503},
+			},
+		},
+		{
+			name: "empty status code list retries no status codes",
+			model: &ProviderRetryModel{
+				MaxRetries:         types.Int64Null(),
+				BaseDelayMs:        types.Int64Null(),
+				MaxDelayMs:         types.Int64Null(),
+				RetryOnStatusCodes: types.ListValueMust(types.Int64Type, []attr.Value{}),
+			},
+			want: &client.RetryConfig{
+				MaxRetries:           defaults.MaxRetries,
+				BaseDelay:            defaults.BaseDelay,
+				MaxDelay:             defaults.MaxDelay,
+				JitterFraction:       defaults.JitterFraction,
+				RetryableStatusCodes: []int{},
+			},
+		},
+		{
+			name: "max delay below base delay",
+			model: &ProviderRetryModel{
+				MaxRetries:         types.Int64Null(),
+				BaseDelayMs:        types.Int64Value(5000),
+				MaxDelayMs:         types.Int64Value(1000),
+				RetryOnStatusCodes: types.ListNull(types.Int64Type),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := retryConfigFromModel(ctx, tt.model)
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("retryConfigFromModel() diagnostics = %v, wantError %v", diags, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("retryConfigFromModel() = %+v, want %+v", got, tt.want)
+			}
+
+			// Resources and data sources read the config back through ProviderData
+			data := &ProviderData{RetryConfig: got}
+			if data.retryConfig() != got {
+				t.Error("ProviderData.retryConfig() did not return the configured RetryConfig")
+			}
+		})
+	}
+
+	var unconfigured *ProviderData
+	if !reflect.DeepEqual(unconfigured.retryConfig(), defaults) {
+		t.Errorf("nil ProviderData.retryConfig() = %+v, want defaults", unconfigured.retryConfig())
+	}
+}
+
+// TestAccProvider_invalidCredentials tests that bad credentials fail provider configuration cleanly
+// Validates:
+// - Invalid CYBERARK_PASSWORD surfaces an "Authentication Failed" diagnostic from Configure
+// - No panic or raw SDK error leaks out of provider initialization
+func TestAccProvider_invalidCredentials(t *testing.T) {
+	// Keep the real username so the SDK can still resolve the tenant Identity URL
+	t.Setenv(EnvPassword, "invalid-password-for-acceptance-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfigInvalidCredentials,
+				ExpectError: regexp.MustCompile(`(?i)Authentication Failed - provider configuration`),
+			},
+		},
+	})
+}
+
+const testAccProviderConfigInvalidCredentials = `
+provider "cyberarksia" {}
+
+data "cyberarksia_principal" "invalid_credentials" {
+  name = "tim.schindler@cyberark.cloud.40562"
+  type = "USER"
+}
+`
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -59,6 +61,8 @@ type CertificateModel struct {
 
 	// Nested Metadata Block
 	Metadata types.Object `tfsdk:"metadata"` // Certificate metadata (issuer, subject, etc.)
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // CertificateMetadataModel represents the nested metadata block
@@ -114,14 +118,16 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 					"Maximum size: 4 KB. " +
 					"CRITICAL: This attribute must persist in state as it's required for all update operations. " +
 					"The API may normalize whitespace/line endings, so state will reflect server-side format.",
-				Optional: true, // Cannot be Required+Computed (framework limitation)
-				Computed: true, // API may normalize formatting (whitespace, line endings)
+				Optional:  true, // Cannot be Required+Computed (framework limitation)
+				Computed:  true, // API may normalize formatting (whitespace, line endings)
+				Sensitive: true, // Keep multi-KB PEM bodies out of plan output
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(), // Persist for updates
 					stringplanmodifier.RequiresReplace(),    // Change forces replacement
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1), // Must not be empty
+					validators.PEMCertificate(),      // Reject invalid PEM at plan time
 				},
 			},
 			"cert_description": schema.StringAttribute{
@@ -197,6 +203,10 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -224,6 +234,7 @@ func (r *CertificateResource) Configure(ctx context.Context, req resource.Config
 		)
 		return
 	}
+	certsClient.SetRetryConfig(providerData.retryConfig())
 
 	r.certificatesAPI = certsClient
 	r.providerData = providerData
@@ -291,37 +302,7 @@ func mapCertificateToState(ctx context.Context, cert *client.Certificate, model
 
 	// Map metadata object if present
 	if cert.Metadata != nil {
-		// Convert SANs to types.List
-		var sansList types.List
-		if cert.Metadata.SubjectAlternativeName != nil {
-			sansListVal, sansDiags := types.ListValueFrom(ctx, types.StringType, cert.Metadata.SubjectAlternativeName)
-			diags.Append(sansDiags...)
-			if !diags.HasError() {
-				sansList = sansListVal
-			}
-		} else {
-			sansList = types.ListNull(types.StringType)
-		}
-
-		// Build metadata object
-		metadataModel := CertificateMetadataModel{
-			Issuer:                 types.StringValue(cert.Metadata.Issuer),
-			Subject:                types.StringValue(cert.Metadata.Subject),
-			ValidFrom:              types.StringValue(cert.Metadata.ValidFrom),
-			ValidTo:                types.StringValue(cert.Metadata.ValidTo),
-			SerialNumber:           types.StringValue(cert.Metadata.SerialNumber),
-			SubjectAlternativeName: sansList,
-		}
-
-		// Convert to types.Object
-		metadataObj, metaDiags := types.ObjectValueFrom(ctx, map[string]attr.Type{
-			"issuer":                   types.StringType,
-			"subject":                  types.StringType,
-			"valid_from":               types.StringType,
-			"valid_to":                 types.StringType,
-			"serial_number":            types.StringType,
-			"subject_alternative_name": types.ListType{ElemType: types.StringType},
-		}, metadataModel)
+		metadataObj, metaDiags := certificateMetadataObject(ctx, cert.Metadata)
 		diags.Append(metaDiags...)
 		if !diags.HasError() {
 			model.Metadata = metadataObj
@@ -329,6 +310,51 @@ func mapCertificateToState(ctx context.Context, cert *client.Certificate, model
 	}
 }
 
+// certificateMetadataAttrTypes returns the attribute types of the nested metadata object
+func certificateMetadataAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"issuer":                   types.StringType,
+		"subject":                  types.StringType,
+		"valid_from":               types.StringType,
+		"valid_to":                 types.StringType,
+		"serial_number":            types.StringType,
+		"subject_alternative_name": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// certificateMetadataObject converts API certificate metadata to the nested metadata object
+func certificateMetadataObject(ctx context.Context, metadata *client.CertificateMetadata) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if metadata == nil {
+		return types.ObjectNull(certificateMetadataAttrTypes()), diags
+	}
+
+	// Convert SANs to types.List
+	sansList := types.ListNull(types.StringType)
+	if metadata.SubjectAlternativeName != nil {
+		sansListVal, sansDiags := types.ListValueFrom(ctx, types.StringType, metadata.SubjectAlternativeName)
+		diags.Append(sansDiags...)
+		if diags.HasError() {
+			return types.ObjectNull(certificateMetadataAttrTypes()), diags
+		}
+		sansList = sansListVal
+	}
+
+	metadataModel := CertificateMetadataModel{
+		Issuer:                 types.StringValue(metadata.Issuer),
+		Subject:                types.StringValue(metadata.Subject),
+		ValidFrom:              types.StringValue(metadata.ValidFrom),
+		ValidTo:                types.StringValue(metadata.ValidTo),
+		SerialNumber:           types.StringValue(metadata.SerialNumber),
+		SubjectAlternativeName: sansList,
+	}
+
+	metadataObj, metaDiags := types.ObjectValueFrom(ctx, certificateMetadataAttrTypes(), metadataModel)
+	diags.Append(metaDiags...)
+	return metadataObj, diags
+}
+
 // Create creates the certificate resource and sets the initial Terraform state
 func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan CertificateModel
@@ -339,6 +365,14 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Validate certificate content before API call (no expiration check per Issue #13)
 	certBody := plan.CertBody.ValueString()
 	if err := client.ValidatePEMCertificate(certBody); err != nil {
@@ -390,7 +424,7 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	tflog.Info(ctx, "Certificate created successfully", map[string]interface{}{
-		"certificate_id": certificate.CertificateID,
+		logKeyCertificateID: certificate.CertificateID,
 	})
 
 	// Fetch full certificate details to populate ALL computed fields
@@ -400,10 +434,17 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.Append(client.MapCertificateError(err, "read certificate after create"))
 		return
 	}
+	if fullCertificate == nil {
+		resp.Diagnostics.AddError(
+			"Certificate Not Found",
+			fmt.Sprintf("Certificate %s was not found when reading it back after create.", certificate.CertificateID),
+		)
+		return
+	}
 
 	// DEBUG: Log the actual API response to see what we're getting
 	tflog.Debug(ctx, "API GET Certificate Response", map[string]interface{}{
-		"certificate_id": fullCertificate.CertificateID,
+		logKeyCertificateID: fullCertificate.CertificateID,
 	})
 
 	// Map GET response to Terraform state using helper
@@ -427,11 +468,19 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get certificate ID from state
 	certificateID := state.CertificateID.ValueString()
 
 	tflog.Info(ctx, "Reading certificate", map[string]interface{}{
-		"certificate_id": certificateID,
+		logKeyCertificateID: certificateID,
 	})
 
 	// Call API to get certificate details
@@ -440,7 +489,7 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 		// Handle 404 Not Found: Remove from state (drift detection)
 		if client.IsNotFoundError(err) {
 			tflog.Warn(ctx, "Certificate not found, removing from state", map[string]interface{}{
-				"certificate_id": certificateID,
+				logKeyCertificateID: certificateID,
 			})
 			resp.State.RemoveResource(ctx)
 			return
@@ -451,8 +500,17 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// GetCertificate returns nil, nil for 404 (drift detection pattern)
+	if certificate == nil {
+		tflog.Warn(ctx, "Certificate not found, removing from state", map[string]interface{}{
+			logKeyCertificateID: certificateID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	tflog.Info(ctx, "Certificate read successfully", map[string]interface{}{
-		"certificate_id": certificate.CertificateID,
+		logKeyCertificateID: certificate.CertificateID,
 	})
 
 	// Map GET response to Terraform state using helper
@@ -463,7 +521,7 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Drift detection: Log state changes
 	tflog.Debug(ctx, "Drift detection", map[string]interface{}{
-		"certificate_id": certificate.CertificateID,
+		logKeyCertificateID: certificate.CertificateID,
 	})
 
 	// Save updated data into Terraform state
@@ -481,6 +539,14 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Get certificate ID from state
 	certificateID := state.CertificateID.ValueString()
 
@@ -536,8 +602,8 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Call API to update certificate
 	tflog.Info(ctx, "Updating certificate", map[string]interface{}{
-		"certificate_id": certificateID,
-		"cert_name":      updateReq.CertName,
+		logKeyCertificateID: certificateID,
+		"cert_name":         updateReq.CertName,
 		// NEVER log cert_body or cert_password!
 	})
 
@@ -548,7 +614,7 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	tflog.Info(ctx, "Certificate updated successfully", map[string]interface{}{
-		"certificate_id": certificate.CertificateID,
+		logKeyCertificateID: certificate.CertificateID,
 	})
 
 	// Fetch full certificate details to populate ALL computed fields
@@ -558,9 +624,16 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.Append(client.MapCertificateError(err, "read certificate after update"))
 		return
 	}
+	if fullCertificate == nil {
+		resp.Diagnostics.AddError(
+			"Certificate Not Found",
+			fmt.Sprintf("Certificate %s was not found when reading it back after update.", certificate.CertificateID),
+		)
+		return
+	}
 
 	tflog.Debug(ctx, "API GET Certificate Response after update", map[string]interface{}{
-		"certificate_id": fullCertificate.CertificateID,
+		logKeyCertificateID: fullCertificate.CertificateID,
 	})
 
 	// Map GET response to Terraform state using helper
@@ -584,11 +657,19 @@ func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Get certificate ID from state
 	certificateID := state.CertificateID.ValueString()
 
 	tflog.Info(ctx, "Deleting certificate", map[string]interface{}{
-		"certificate_id": certificateID,
+		logKeyCertificateID: certificateID,
 	})
 
 	// Call API to delete certificate
@@ -600,7 +681,7 @@ func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	tflog.Info(ctx, "Certificate deleted successfully", map[string]interface{}{
-		"certificate_id": certificateID,
+		logKeyCertificateID: certificateID,
 	})
 
 	// Note: State is automatically removed by Terraform framework after successful Delete()
@@ -614,6 +695,6 @@ func (r *CertificateResource) ImportState(ctx context.Context, req resource.Impo
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Importing certificate", map[string]interface{}{
-		"certificate_id": req.ID,
+		logKeyCertificateID: req.ID,
 	})
 }
@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	secretsmodels "github.com/cyberark/ark-sdk-golang/pkg/services/sia/secrets/db/models"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretDataSource{}
+
+func NewSecretDataSource() datasource.DataSource {
+	return &SecretDataSource{}
+}
+
+// SecretDataSource defines the data source implementation.
+type SecretDataSource struct {
+	providerData *ProviderData
+}
+
+// SecretDataSourceModel describes the data source data model.
+// Credentials (password, AWS keys) are deliberately not part of the model,
+// so they can never be written to state by this data source.
+type SecretDataSourceModel struct {
+	// Input
+	Name               types.String `tfsdk:"name"`
+	AuthenticationType types.String `tfsdk:"authentication_type"`
+
+	// Computed
+	ID           types.String `tfsdk:"id"`
+	Username     types.String `tfsdk:"username"`
+	Tags         types.Map    `tfsdk:"tags"`
+	IsActive     types.Bool   `tfsdk:"is_active"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	LastModified types.String `tfsdk:"last_modified"`
+}
+
+// Metadata returns the data source type name.
+func (d *SecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+// Schema defines the schema for the data source.
+func (d *SecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing SIA secret by name. Use this data source to reference secrets managed " +
+			"outside Terraform from `cyberarksia_database_workspace`. Credentials are never read: `password`, " +
+			"`aws_access_key_id` and `aws_secret_access_key` are not part of this data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The exact name of the secret.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"authentication_type": schema.StringAttribute{
+				MarkdownDescription: "Narrows the lookup to one authentication type (`local`, `domain`, or `aws_iam`). " +
+					"When omitted, it is computed from the secret type; username/password secrets are reported as `local`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("local", "domain", "aws_iam"),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The secret ID. Use as `secret_id` in `cyberarksia_database_workspace`.",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Account username, when exposed by the API for username/password secrets.",
+				Computed:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Key-value tags of the secret.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the secret is active.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of creation (ISO 8601).",
+				Computed:            true,
+			},
+			"last_modified": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of last modification (ISO 8601).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source with provider data.
+func (d *SecretDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+// Read lists secrets of the requested type and resolves the single secret with the given name.
+func (d *SecretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.providerData == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured ProviderData. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data SecretDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretName := data.Name.ValueString()
+
+	// The SDK's SecretName filter is a regular expression, so only the type is
+	// filtered server-side and the name is matched exactly below
+	filter := &secretsmodels.ArkSIADBSecretsFilter{}
+	if !data.AuthenticationType.IsNull() {
+		filter.SecretType = secretTypeFromAuthType(data.AuthenticationType.ValueString())
+	}
+
+	var list *secretsmodels.ArkSIADBSecretMetadataList
+	err := client.RetryWithBackoff(ctx, d.providerData.retryConfig(), func() error {
+		var apiErr error
+		list, apiErr = d.providerData.SIAAPI.SecretsDB().ListSecretsBy(filter)
+		return apiErr
+	})
+	if err != nil {
+		resp.Diagnostics.Append(client.MapError(err, "list secrets"))
+		return
+	}
+
+	matches := findSecretsByName(list.Secrets, secretName)
+
+	tflog.Debug(ctx, "Secret lookup complete", map[string]interface{}{
+		"name":        secretName,
+		"secret_type": filter.SecretType,
+		"matches":     len(matches),
+	})
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Secret Not Found",
+			fmt.Sprintf("No secret found with name '%s'. Ensure the secret exists and authentication_type (if set) matches.", secretName),
+		)
+		return
+	}
+	if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.SecretID
+		}
+		resp.Diagnostics.AddError(
+			"Multiple Secrets Found",
+			fmt.Sprintf("Found %d secrets named '%s' (IDs: %s). Set authentication_type to narrow the lookup.",
+				len(matches), secretName, strings.Join(ids, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromSDK(ctx, &matches[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Successfully read secret", map[string]interface{}{
+		logKeySecretID: data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// secretTypeFromAuthType maps authentication_type to the SDK secret type
+func secretTypeFromAuthType(authType string) string {
+	if authType == "aws_iam" {
+		return "iam_user"
+	}
+	return "username_password"
+}
+
+// findSecretsByName returns the secrets whose name equals name exactly
+func findSecretsByName(secrets []secretsmodels.ArkSIADBSecretMetadata, name string) []secretsmodels.ArkSIADBSecretMetadata {
+	var matches []secretsmodels.ArkSIADBSecretMetadata
+	for _, secret := range secrets {
+		if secret.SecretName == name {
+			matches = append(matches, secret)
+		}
+	}
+	return matches
+}
+
+// fromSDK populates the computed attributes from secret metadata. Only the
+// username is taken from secret_exposed_data; nothing else in it is trusted
+// to be non-sensitive.
+func (m *SecretDataSourceModel) fromSDK(ctx context.Context, secret *secretsmodels.ArkSIADBSecretMetadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(secret.SecretID)
+	m.Name = types.StringValue(secret.SecretName)
+	m.IsActive = types.BoolValue(secret.IsActive)
+	m.CreatedAt = types.StringValue(secret.CreationTime)
+	m.LastModified = types.StringValue(secret.LastUpdateTime)
+
+	// Keep a configured authentication_type (domain is indistinguishable from local)
+	if m.AuthenticationType.IsNull() || m.AuthenticationType.IsUnknown() {
+		if authType, ok := secretAuthTypeFromSDK(secret.SecretType); ok {
+			m.AuthenticationType = types.StringValue(authType)
+		} else {
+			m.AuthenticationType = types.StringNull()
+		}
+	}
+
+	m.Username = types.StringNull()
+	if secret.SecretType == "username_password" {
+		if username, ok := secret.SecretExposedData["username"].(string); ok && username != "" {
+			m.Username = types.StringValue(username)
+		}
+	}
+
+	if len(secret.Tags) > 0 {
+		tags, tagDiags := types.MapValueFrom(ctx, types.StringType, secret.Tags)
+		diags.Append(tagDiags...)
+		m.Tags = tags
+	} else {
+		m.Tags = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
@@ -262,11 +262,7 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Wrap SDK call with retry logic per docs/sdk-integration.md
 	var secretMetadata *secretsmodels.ArkSIADBSecretMetadata
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		var apiErr error
 		secretMetadata, apiErr = r.providerData.SIAAPI.SecretsDB().AddSecret(addSecretReq)
 		return apiErr
@@ -274,7 +270,7 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to create secret", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 		resp.Diagnostics.Append(client.MapError(err, "create secret"))
 		return
@@ -286,7 +282,7 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.LastModified = types.StringValue(secretMetadata.LastUpdateTime)
 
 	tflog.Info(ctx, "Created secret", map[string]interface{}{
-		"id": plan.ID.ValueString(),
+		logKeySecretID: plan.ID.ValueString(),
 	})
 
 	// Save data into Terraform state
@@ -312,18 +308,14 @@ func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	tflog.Debug(ctx, "Reading secret", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeySecretID: state.ID.ValueString(),
 	})
 
 	// Per docs/sdk-integration.md: Use siaAPI.SecretsDB().GetSecret()
 	// Note: Response contains metadata only, no sensitive credentials per contract
 	// Handle 404 as resource deleted (drift detection)
 	var secretMetadata *secretsmodels.ArkSIADBSecretMetadata
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		var apiErr error
 		// SDK method signature: Secret(*ArkSIADBGetSecret) (*ArkSIADBSecretMetadata, error)
 		secretMetadata, apiErr = r.providerData.SIAAPI.SecretsDB().Secret(&secretsmodels.ArkSIADBGetSecret{
@@ -336,14 +328,14 @@ func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		// Check if resource was deleted outside Terraform (404)
 		if client.IsNotFoundError(err) {
 			tflog.Warn(ctx, "Secret not found, removing from state", map[string]interface{}{
-				"id": state.ID.ValueString(),
+				logKeySecretID: state.ID.ValueString(),
 			})
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
 		tflog.Error(ctx, "Failed to read secret", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 		resp.Diagnostics.Append(client.MapError(err, "read secret"))
 		return
@@ -367,8 +359,21 @@ func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 		state.Tags = types.MapNull(types.StringType)
 	}
 
+	// After import only the ID is in state; recover what the API exposes so the
+	// next plan does not replace the configured authentication fields
+	if state.AuthenticationType.IsNull() {
+		if authType, ok := secretAuthTypeFromSDK(secretMetadata.SecretType); ok {
+			state.AuthenticationType = types.StringValue(authType)
+		}
+	}
+	if state.Username.IsNull() && secretMetadata.SecretType == "username_password" {
+		if username, ok := secretMetadata.SecretExposedData["username"].(string); ok && username != "" {
+			state.Username = types.StringValue(username)
+		}
+	}
+
 	tflog.Debug(ctx, "Successfully read secret", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeySecretID: state.ID.ValueString(),
 	})
 
 	// Save updated data into Terraform state
@@ -395,7 +400,7 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	tflog.Info(ctx, "Updating secret", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeySecretID: state.ID.ValueString(),
 	})
 
 	// Build update request - handle both metadata and credential updates
@@ -442,11 +447,7 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Wrap SDK call with retry logic
 	var updated *secretsmodels.ArkSIADBSecretMetadata
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		var apiErr error
 		updated, apiErr = r.providerData.SIAAPI.SecretsDB().UpdateSecret(updateReq)
 		return apiErr
@@ -454,7 +455,7 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	if err != nil {
 		tflog.Error(ctx, "Failed to update secret", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 		resp.Diagnostics.Append(client.MapError(err, "update secret"))
 		return
@@ -464,7 +465,7 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.LastModified = types.StringValue(updated.LastUpdateTime)
 
 	tflog.Info(ctx, "Updated secret", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeySecretID: state.ID.ValueString(),
 	})
 
 	// Save updated data into Terraform state
@@ -490,18 +491,14 @@ func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	tflog.Info(ctx, "Deleting secret", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeySecretID: state.ID.ValueString(),
 	})
 
 	// WORKAROUND: ARK SDK v1.5.0 Bug - DeleteSecret() panics with nil body
 	// Use direct HTTP DELETE with empty map workaround instead of SDK method
 	// See internal/client/delete_workarounds.go for details
 	// TODO: Revert to SDK method when v1.6.0+ fixes nil body handling
-	err := client.RetryWithBackoff(ctx, &client.RetryConfig{
-		MaxRetries: client.DefaultMaxRetries,
-		BaseDelay:  client.BaseDelay,
-		MaxDelay:   client.MaxDelay,
-	}, func() error {
+	err := client.RetryWithBackoff(ctx, r.providerData.retryConfig(), func() error {
 		return client.DeleteSecretDirect(ctx, r.providerData.AuthContext, state.ID.ValueString())
 	})
 
@@ -509,20 +506,20 @@ func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		// Gracefully handle already-deleted resource (404)
 		if client.IsNotFoundError(err) {
 			tflog.Warn(ctx, "Secret already deleted", map[string]interface{}{
-				"id": state.ID.ValueString(),
+				logKeySecretID: state.ID.ValueString(),
 			})
 			return
 		}
 
 		tflog.Error(ctx, "Failed to delete secret", map[string]interface{}{
-			"error": err.Error(),
+			logKeyError: err.Error(),
 		})
 		resp.Diagnostics.Append(client.MapError(err, "delete secret"))
 		return
 	}
 
 	tflog.Info(ctx, "Deleted secret", map[string]interface{}{
-		"id": state.ID.ValueString(),
+		logKeySecretID: state.ID.ValueString(),
 	})
 }
 
@@ -532,7 +529,7 @@ func (r *secretResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 
 	tflog.Info(ctx, "Imported secret", map[string]interface{}{
-		"id": req.ID,
+		logKeySecretID: req.ID,
 	})
 }
 
@@ -624,3 +621,16 @@ func (r *secretResource) ValidateConfig(ctx context.Context, req resource.Valida
 		}
 	}
 }
+
+// secretAuthTypeFromSDK maps an SDK secret type to authentication_type.
+// username_password is reported as local since domain secrets are indistinguishable
+func secretAuthTypeFromSDK(secretType string) (string, bool) {
+	switch secretType {
+	case "username_password":
+		return "local", true
+	case "iam_user":
+		return "aws_iam", true
+	default:
+		return "", false
+	}
+}
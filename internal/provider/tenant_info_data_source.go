@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TenantInfoDataSource{}
+
+func NewTenantInfoDataSource() datasource.DataSource {
+	return &TenantInfoDataSource{}
+}
+
+// TenantInfoDataSource defines the data source implementation.
+type TenantInfoDataSource struct {
+	providerData *ProviderData
+}
+
+// TenantInfoDataSourceModel describes the data source data model.
+type TenantInfoDataSourceModel struct {
+	// Computed attributes (no inputs)
+	TenantID        types.String `tfsdk:"tenant_id"`
+	Subdomain       types.String `tfsdk:"subdomain"`
+	Domain          types.String `tfsdk:"domain"`
+	SIAURL          types.String `tfsdk:"sia_url"`
+	ProviderVersion types.String `tfsdk:"provider_version"`
+}
+
+// Metadata returns the data source type name.
+func (d *TenantInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *TenantInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns metadata about the CyberArk tenant the provider is authenticated against. " +
+			"Takes no arguments. Use this data source to reference the tenant ID or SIA URL in other configuration.",
+
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The tenant's unique identifier.",
+				Computed:            true,
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "The tenant subdomain (e.g., `abc123` for `abc123.cyberark.cloud`).",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The platform domain (e.g., `cyberark.cloud` or `cyberarkgov.cloud`).",
+				Computed:            true,
+			},
+			"sia_url": schema.StringAttribute{
+				MarkdownDescription: "The SIA API base URL used by the provider (e.g., `https://abc123.dpa.cyberark.cloud`).",
+				Computed:            true,
+			},
+			"provider_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the provider plugin.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source with provider data.
+func (d *TenantInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerData = providerData
+}
+
+// Read resolves tenant metadata from the provider's authenticated session.
+func (d *TenantInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.providerData == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured API Client",
+			"Expected configured ProviderData. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	info, err := client.GetTenantInfo(ctx, d.providerData.AuthContext)
+	if err != nil {
+		resp.Diagnostics.Append(client.MapError(err, "read tenant info"))
+		return
+	}
+
+	tflog.Debug(ctx, "Resolved tenant info", map[string]interface{}{
+		"tenant_id": info.TenantID,
+		"sia_url":   info.SIAURL,
+	})
+
+	data := TenantInfoDataSourceModel{
+		TenantID:        types.StringValue(info.TenantID),
+		Subdomain:       types.StringValue(info.Subdomain),
+		Domain:          types.StringValue(info.Domain),
+		SIAURL:          types.StringValue(info.SIAURL),
+		ProviderVersion: types.StringValue(d.providerData.Version),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
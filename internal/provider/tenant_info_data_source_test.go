@@ -0,0 +1,31 @@
+// Package provider implements acceptance tests for tenant_info_data_source
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccTenantInfo_basic tests that all tenant metadata is resolved from the provider session
+func TestAccTenantInfo_basic(t *testing.T) {
+	nonEmpty := regexp.MustCompile(`^.+$`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "cyberarksia_tenant_info" "current" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.cyberarksia_tenant_info.current", "tenant_id", nonEmpty),
+					resource.TestMatchResourceAttr("data.cyberarksia_tenant_info.current", "subdomain", nonEmpty),
+					resource.TestMatchResourceAttr("data.cyberarksia_tenant_info.current", "domain", nonEmpty),
+					resource.TestMatchResourceAttr("data.cyberarksia_tenant_info.current", "sia_url", regexp.MustCompile(`^https://.+\.dpa\..+$`)),
+					resource.TestCheckResourceAttr("data.cyberarksia_tenant_info.current", "provider_version", "test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+// Package provider implements the CyberArk SIA Terraform provider
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default operation timeouts, used when a resource's timeouts block does not set one.
+// ARK SDK v1.5.0 calls take no context, so a timeout only cancels RetryWithBackoff
+// between attempts; a request already in flight runs to completion.
+const (
+	defaultCreateTimeout = 5 * time.Minute
+	defaultReadTimeout   = 2 * time.Minute
+	defaultUpdateTimeout = 5 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
+// timeoutNote explains how far the timeouts reach, appended to each description
+const timeoutNote = " SDK calls cannot be cancelled, so the timeout stops further retries and backoff waits but does not interrupt a request already in progress."
+
+// timeoutsBlock returns the `timeouts` block shared by all resources
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: "Timeout for creating the resource (e.g. `10m`). Default: `5m`." + timeoutNote,
+		ReadDescription:   "Timeout for reading the resource (e.g. `5m`). Default: `2m`." + timeoutNote,
+		UpdateDescription: "Timeout for updating the resource (e.g. `10m`). Default: `5m`." + timeoutNote,
+		DeleteDescription: "Timeout for deleting the resource (e.g. `10m`). Default: `5m`." + timeoutNote,
+	})
+}
+
+// nullTimeouts returns an unset timeouts value for state built from the API
+// rather than from a plan or prior state, e.g. on import
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
@@ -0,0 +1,28 @@
+// Package provider implements tests for resource timeouts
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Test that nullTimeouts matches the block type, so state built on import can be saved
+func TestNullTimeouts_matchesBlockType(t *testing.T) {
+	ctx := context.Background()
+
+	block, ok := timeoutsBlock(ctx).(schema.SingleNestedBlock)
+	if !ok {
+		t.Fatalf("timeoutsBlock() = %T, want schema.SingleNestedBlock", timeoutsBlock(ctx))
+	}
+	for _, name := range []string{"create", "read", "update", "delete"} {
+		if _, ok := block.Attributes[name]; !ok {
+			t.Errorf("timeoutsBlock() missing %q attribute", name)
+		}
+	}
+
+	if got, want := nullTimeouts().Type(ctx), block.Type(); !got.Equal(want) {
+		t.Errorf("nullTimeouts() type = %s, want %s", got, want)
+	}
+}
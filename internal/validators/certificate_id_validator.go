@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// siaCertificateIDValidator validates that a string is a valid SIA certificate ID
+type siaCertificateIDValidator struct{}
+
+// SIA certificate IDs are 16-digit numeric strings (e.g., "1761251731882561")
+var siaCertificateIDPattern = regexp.MustCompile(`^[0-9]{16}$`)
+
+// Description returns a plain text description of the validator's behavior
+func (v siaCertificateIDValidator) Description(ctx context.Context) string {
+	return "Value must be a valid SIA certificate ID: a 16-digit numeric string (e.g., '1761251731882561')"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior
+func (v siaCertificateIDValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a valid SIA certificate ID: a 16-digit numeric string (e.g., `1761251731882561`)"
+}
+
+// ValidateString validates the SIA certificate ID format
+func (v siaCertificateIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip validation if value is unknown or null (during plan phase)
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if !siaCertificateIDPattern.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Certificate ID Format",
+			fmt.Sprintf("Value %q is not a valid SIA certificate ID. Expected a 16-digit numeric string (e.g., '1761251731882561'). "+
+				"Reference the certificate resource instead of hardcoding the ID: cyberarksia_certificate.<name>.id", value),
+		)
+	}
+}
+
+// SIACertificateID returns a validator that ensures the string is a valid SIA certificate ID
+func SIACertificateID() validator.String {
+	return siaCertificateIDValidator{}
+}
@@ -0,0 +1,115 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSIACertificateIDValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		expectErr bool
+	}{
+		{
+			name:      "valid certificate ID",
+			value:     types.StringValue("1761251731882561"),
+			expectErr: false,
+		},
+		{
+			name:      "valid all zeros",
+			value:     types.StringValue("0000000000000000"),
+			expectErr: false,
+		},
+		{
+			name:      "invalid too short",
+			value:     types.StringValue("176125173188256"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid too long",
+			value:     types.StringValue("17612517318825610"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid non-numeric character",
+			value:     types.StringValue("176125173188256a"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid UUID",
+			value:     types.StringValue("550e8400-e29b-41d4-a716-446655440000"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid leading whitespace",
+			value:     types.StringValue(" 1761251731882561"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid trailing newline",
+			value:     types.StringValue("1761251731882561\n"),
+			expectErr: true,
+		},
+		{
+			name:      "invalid negative number",
+			value:     types.StringValue("-761251731882561"),
+			expectErr: true,
+		},
+		{
+			name:      "empty string",
+			value:     types.StringValue(""),
+			expectErr: true,
+		},
+		{
+			name:      "null value (allowed)",
+			value:     types.StringNull(),
+			expectErr: false,
+		},
+		{
+			name:      "unknown value (allowed)",
+			value:     types.StringUnknown(),
+			expectErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := SIACertificateID()
+			req := validator.StringRequest{
+				Path:        path.Root("certificate_id"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+
+			v.ValidateString(context.Background(), req, resp)
+
+			hasError := resp.Diagnostics.HasError()
+			if hasError != tt.expectErr {
+				t.Errorf("SIACertificateID() hasError = %v, expectErr %v", hasError, tt.expectErr)
+				if hasError {
+					t.Logf("Diagnostics: %v", resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
+
+func TestSIACertificateIDValidator_Description(t *testing.T) {
+	v := SIACertificateID()
+	ctx := context.Background()
+
+	desc := v.Description(ctx)
+	if desc == "" {
+		t.Error("Description() returned empty string")
+	}
+
+	markdownDesc := v.MarkdownDescription(ctx)
+	if markdownDesc == "" {
+		t.Error("MarkdownDescription() returned empty string")
+	}
+}
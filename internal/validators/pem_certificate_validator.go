@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/client"
+)
+
+// pemCertificateValidator validates that a string is a PEM encoded X.509 certificate
+type pemCertificateValidator struct{}
+
+// Description returns a plain text description of the validator's behavior
+func (v pemCertificateValidator) Description(ctx context.Context) string {
+	return "Value must be a PEM encoded X.509 certificate without private key material"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior
+func (v pemCertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a PEM encoded X.509 certificate (`-----BEGIN CERTIFICATE-----`) without private key material"
+}
+
+// ValidateString runs the same checks the certificate resource applies before calling the API,
+// so invalid content fails at plan time instead of during apply
+func (v pemCertificateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip validation if value is unknown or null (during plan phase)
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if err := client.ValidatePEMCertificate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Certificate Content",
+			fmt.Sprintf("Certificate validation failed: %s\n\n"+
+				"Ensure cert_body contains a valid PEM-encoded certificate without private key material.", err.Error()),
+		)
+	}
+}
+
+// PEMCertificate returns a validator that ensures the string is a PEM encoded X.509 certificate
+func PEMCertificate() validator.String {
+	return pemCertificateValidator{}
+}
@@ -23,14 +23,17 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/aaearon/cyberarksia",
-		Debug:   debug,
-	}
-
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err := providerserver.Serve(context.Background(), provider.New(version), serveOpts(debug))
 
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// serveOpts returns the provider server options for the given debug mode
+func serveOpts(debug bool) providerserver.ServeOpts {
+	return providerserver.ServeOpts{
+		Address: "registry.terraform.io/aaearon/cyberarksia",
+		Debug:   debug,
+	}
+}
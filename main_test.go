@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/aaearon/terraform-provider-cyberark-sia/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+)
+
+func TestServeOpts(t *testing.T) {
+	for _, debug := range []bool{false, true} {
+		opts := serveOpts(debug)
+
+		if opts.Address != "registry.terraform.io/aaearon/cyberarksia" {
+			t.Errorf("serveOpts(%t).Address = %q, want registry address", debug, opts.Address)
+		}
+		if opts.Debug != debug {
+			t.Errorf("serveOpts(%t).Debug = %t", debug, opts.Debug)
+		}
+	}
+}
+
+// Debug mode serves the same protocol 6 server as normal mode; building it must not panic or error
+func TestProviderServer_Debug(t *testing.T) {
+	if opts := serveOpts(true); opts.ProtocolVersion != 0 {
+		t.Fatalf("serveOpts(true).ProtocolVersion = %d, want default (protocol 6)", opts.ProtocolVersion)
+	}
+
+	server, err := providerserver.NewProtocol6WithError(provider.New(version)())()
+	if err != nil {
+		t.Fatalf("failed to build provider server: %s", err)
+	}
+	if server == nil {
+		t.Fatal("provider server is nil")
+	}
+}